@@ -0,0 +1,123 @@
+package onesky
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Cache is a struct which contains settings of on-disk cache of DownloadFile. Files are stored per project,
+// locale and file name, so cache directory can be shared by clients of several projects, e.g. on CI runners.
+type Cache struct {
+	// Dir is a directory of cached files
+	Dir string
+	// TTL is a time for which cached file is served without request, cached files are always revalidated when it is zero
+	TTL time.Duration
+	// Offline serves files only from cache, error is returned for files which are not cached
+	Offline bool
+}
+
+// CacheEntry is a struct which contains informations about cached file
+type CacheEntry struct {
+	ProjectID int       `json:"project_id"`
+	FileName  string    `json:"file_name"`
+	Locale    string    `json:"locale"`
+	SHA256    string    `json:"sha256"`
+	Size      int       `json:"size"`
+	Fetched   time.Time `json:"fetched"`
+}
+
+// Get returns content and entry of cached file, ok is false when file is not cached or its content does not match hash
+func (c *Cache) Get(projectID int, fileName, locale string) (string, CacheEntry, bool) {
+	path, err := c.path(projectID, fileName, locale)
+	if err != nil {
+		return "", CacheEntry{}, false
+	}
+	data, err := ioutil.ReadFile(path + ".meta")
+	if err != nil {
+		return "", CacheEntry{}, false
+	}
+	entry := CacheEntry{}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return "", CacheEntry{}, false
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil || contentHash(content) != entry.SHA256 {
+		return "", CacheEntry{}, false
+	}
+
+	return string(content), entry, true
+}
+
+// Put stores file in cache, content is written only when its hash differs from cached one
+func (c *Cache) Put(projectID int, fileName, locale, content string) (CacheEntry, error) {
+	path, err := c.path(projectID, fileName, locale)
+	if err != nil {
+		return CacheEntry{}, err
+	}
+
+	entry := CacheEntry{ProjectID: projectID, FileName: fileName, Locale: locale, SHA256: contentHash([]byte(content)), Size: len(content), Fetched: time.Now().UTC()}
+	if _, cached, ok := c.Get(projectID, fileName, locale); !ok || cached.SHA256 != entry.SHA256 {
+		if err := writeFileAtomic(path, []byte(content)); err != nil {
+			return CacheEntry{}, err
+		}
+	}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return CacheEntry{}, err
+	}
+	if err := writeFileAtomic(path+".meta", append(data, '\n')); err != nil {
+		return CacheEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// download returns cached file when it is fresh or cache is offline, file is fetched and stored otherwise
+func (c *Cache) download(projectID int, fileName, locale string, fetch func() (string, error)) (string, error) {
+	content, entry, ok := c.Get(projectID, fileName, locale)
+	if c.Offline {
+		if !ok {
+			return "", fmt.Errorf("offline: %s in %s is not cached", fileName, locale)
+		}
+		return content, nil
+	}
+	if ok && c.TTL > 0 && time.Since(entry.Fetched) < c.TTL {
+		return content, nil
+	}
+
+	content, err := fetch()
+	if err != nil {
+		return "", err
+	}
+	if _, err := c.Put(projectID, fileName, locale, content); err != nil {
+		return "", err
+	}
+
+	return content, nil
+}
+
+// path returns path of cached file, locale and file name are escaped so they can not leave cache directory
+func (c *Cache) path(projectID int, fileName, locale string) (string, error) {
+	if c.Dir == "" {
+		return "", fmt.Errorf("cache directory is not set")
+	}
+	l, f := url.PathEscape(locale), url.PathEscape(fileName)
+	if l == "" || f == "" || l == "." || l == ".." || f == "." || f == ".." {
+		return "", fmt.Errorf("invalid file %s in %s", fileName, locale)
+	}
+
+	return filepath.Join(c.Dir, strconv.Itoa(projectID), l, f), nil
+}
+
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
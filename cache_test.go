@@ -0,0 +1,98 @@
+package onesky
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadFileWithCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	downloads, content := 0, "a: 1"
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", func(req *http.Request) (*http.Response, error) {
+		downloads++
+		return httpmock.NewStringResponse(200, content), nil
+	})
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	cache := &Cache{Dir: tmpdir}
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1, Cache: cache}
+
+	res, err := client.DownloadFile("app/en.yml", "zh-TW")
+	assert.Nil(t, err)
+	assert.Equal(t, "a: 1", res)
+	assert.Equal(t, 1, downloads)
+	path := filepath.Join(tmpdir, "1", "zh-TW", "app%2Fen.yml")
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "a: 1", string(data))
+	_, first, ok := cache.Get(1, "app/en.yml", "zh-TW")
+	assert.True(t, ok)
+	assert.Equal(t, CacheEntry{ProjectID: 1, FileName: "app/en.yml", Locale: "zh-TW", SHA256: "16c3c6d78678d53d39ab2c7a5a7bc4596567b46a1a57000a2d707334f779b824", Size: 4, Fetched: first.Fetched}, first)
+
+	// unchanged content is not written again
+	old := time.Now().Add(-time.Hour)
+	assert.Nil(t, os.Chtimes(path, old, old))
+	_, err = client.DownloadFile("app/en.yml", "zh-TW")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, downloads)
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.True(t, info.ModTime().Before(time.Now().Add(-time.Minute)))
+	_, second, _ := cache.Get(1, "app/en.yml", "zh-TW")
+	assert.False(t, second.Fetched.Before(first.Fetched))
+
+	content = "a: 2"
+	cache.TTL = time.Hour
+	res, err = client.DownloadFile("app/en.yml", "zh-TW")
+	assert.Nil(t, err)
+	assert.Equal(t, "a: 1", res)
+	assert.Equal(t, 2, downloads)
+
+	cache.TTL = 0
+	res, err = client.DownloadFile("app/en.yml", "zh-TW")
+	assert.Nil(t, err)
+	assert.Equal(t, "a: 2", res)
+	data, err = ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "a: 2", string(data))
+
+	cache.Offline = true
+	res, err = client.DownloadFile("app/en.yml", "zh-TW")
+	assert.Nil(t, err)
+	assert.Equal(t, "a: 2", res)
+	_, err = client.DownloadFile("app/en.yml", "de")
+	assert.Equal(t, "offline: app/en.yml in de is not cached", err.Error())
+	assert.Equal(t, 3, downloads)
+
+	// other projects do not share entries
+	_, _, ok = cache.Get(2, "app/en.yml", "zh-TW")
+	assert.False(t, ok)
+}
+
+func TestCacheIgnoresCorruptedFile(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	cache := &Cache{Dir: tmpdir}
+
+	_, err = cache.Put(1, "en.yml", "en", "a: 1")
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpdir, "1", "en", "en.yml"), []byte("a: 3"), 0644))
+	_, _, ok := cache.Get(1, "en.yml", "en")
+	assert.False(t, ok)
+
+	_, err = cache.Put(1, "..", "en", "a: 1")
+	assert.NotNil(t, err)
+	_, err = (&Cache{}).Put(1, "en.yml", "en", "a: 1")
+	assert.Equal(t, "cache directory is not set", err.Error())
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// runConvert converts file given as argument or stdin and writes it to -o file or stdout
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	from := fs.String("from", "", "format of input file, e.g. HIERARCHICAL_JSON")
+	to := fs.String("to", "", "format of output file, e.g. JAVA_PROPERTIES")
+	output := fs.String("o", "", "output file (default: stdout)")
+	cv := &formats.Converter{}
+	fs.StringVar(&cv.Separator, "separator", "", "separator of nested keys (default: .)")
+	fs.StringVar(&cv.Locale, "locale", "", "locale of converted file")
+	fs.BoolVar(&cv.Strict, "strict", false, "fail when conversion is lossy")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: onesky convert -from FORMAT -to FORMAT [flags] [file]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" || *to == "" || fs.NArg() > 1 {
+		fs.Usage()
+		return fmt.Errorf("-from and -to are required")
+	}
+
+	in := stdin
+	if fs.NArg() == 1 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var b bytes.Buffer
+	losses, err := cv.Convert(formats.Format(*from), formats.Format(*to), in, &b)
+	for _, l := range losses {
+		fmt.Fprintf(stderr, "warning: %s\n", l)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *output != "" {
+		return ioutil.WriteFile(*output, b.Bytes(), 0644)
+	}
+	_, err = b.WriteTo(stdout)
+
+	return err
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertCommand(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader(`{"a": {"b": "c", "n": {"one": "1", "other": "2"}}}`)
+	code := run([]string{"convert", "-from", "HIERARCHICAL_JSON", "-to", "JAVA_PROPERTIES"}, stdin, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "a.b=c\na.n.one=1\na.n.other=2\n", stdout.String())
+	assert.Equal(t, "warning: a.n: plural forms flattened into separate keys\n", stderr.String())
+
+	dir, err := ioutil.TempDir("", "onesky")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	in := filepath.Join(dir, "en.properties")
+	out := filepath.Join(dir, "en.yml")
+	assert.Nil(t, ioutil.WriteFile(in, []byte("a_b=c\n"), 0644))
+
+	stdout.Reset()
+	stderr.Reset()
+	code = run([]string{"convert", "-from", "JAVA_PROPERTIES", "-to", "RUBY_YAML", "-separator", "_", "-locale", "en", "-o", out, in}, nil, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	data, err := ioutil.ReadFile(out)
+	assert.Nil(t, err)
+	assert.Equal(t, "en:\n  a:\n    b: c\n", string(data))
+
+	stdin = strings.NewReader(`{"n": {"one": "1", "other": "2"}}`)
+	code = run([]string{"convert", "-from", "HIERARCHICAL_JSON", "-to", "IOS_STRINGS", "-strict", "-o", out}, stdin, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	data, err = ioutil.ReadFile(out)
+	assert.Nil(t, err)
+	assert.Equal(t, "en:\n  a:\n    b: c\n", string(data))
+
+	code = run([]string{"convert", "-from", "HIERARCHICAL_JSON", "-to", "IOS_STRINGS", filepath.Join(dir, "missing.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/gen"
+)
+
+// runGen generates code of language given as first argument
+func runGen(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 || args[0] != "go" {
+		fmt.Fprintln(stderr, "Usage: onesky gen go [flags] file")
+		return fmt.Errorf("supported languages: go")
+	}
+
+	return runGenGo(args[1:], stdout, stderr)
+}
+
+// runGenGo downloads source file with DownloadFile and writes Go package with typed accessors of its messages
+func runGenGo(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("gen go", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	path := fs.String("config", config.DefaultFileName, "project config file")
+	pkg := fs.String("package", gen.DefaultPackage, "name of generated package")
+	output := fs.String("o", "", "output file (default: stdout)")
+	locale := fs.String("locale", "", "locale of downloaded source file (default: source_locale of config)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: onesky gen go [flags] file")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("OneSky file name is required")
+	}
+	name := fs.Arg(0)
+
+	cfg, client, err := loadProject(*path)
+	if err != nil {
+		return err
+	}
+	sources, err := cfg.Sources()
+	if err != nil {
+		return err
+	}
+	format := ""
+	for _, s := range sources {
+		if s.Name == name {
+			format = s.Format
+		}
+	}
+	if format == "" {
+		return fmt.Errorf("file %s is not defined in %s", name, *path)
+	}
+	if *locale == "" {
+		*locale = cfg.SourceLocale
+	}
+	if *locale == "" {
+		return fmt.Errorf("-locale or source_locale of config is required")
+	}
+
+	content, err := client.DownloadFile(name, *locale)
+	if err != nil {
+		return err
+	}
+	catalog, err := formats.ParseString(formats.Format(format), content)
+	if err != nil {
+		return fmt.Errorf("%s: %s", name, err)
+	}
+	catalog.Locale = *locale
+
+	var b bytes.Buffer
+	if err := gen.Go(&b, catalog, gen.Options{Package: *pkg, Source: name}); err != nil {
+		return err
+	}
+	if *output != "" {
+		return ioutil.WriteFile(*output, b.Bytes(), 0644)
+	}
+	_, err = b.WriteTo(stdout)
+
+	return err
+}
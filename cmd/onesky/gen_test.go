@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenCommand(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", httpmock.NewStringResponder(200, `{"greeting": "Hello %s"}`))
+
+	dir, err := ioutil.TempDir("", "onesky")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := filepath.Join(dir, ".onesky.yml")
+	assert.Nil(t, ioutil.WriteFile(cfg, []byte("project_id: 1\nsource_locale: en\noutput: \"{locale}/{file}\"\nfiles:\n  - source: en.json\n    format: HIERARCHICAL_JSON\n"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "en.json"), []byte(`{}`), 0644))
+
+	os.Setenv("ONESKY_API_KEY", "abcdef")
+	os.Setenv("ONESKY_SECRET", "abcdef")
+	defer os.Unsetenv("ONESKY_API_KEY")
+	defer os.Unsetenv("ONESKY_SECRET")
+
+	var stdout, stderr bytes.Buffer
+	out := filepath.Join(dir, "messages.go")
+	code := run([]string{"gen", "go", "-config", cfg, "-package", "i18n", "-o", out, "en.json"}, nil, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	data, err := ioutil.ReadFile(out)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "package i18n\n")
+	assert.Contains(t, string(data), "const SourceLocale = \"en\"\n")
+	assert.Contains(t, string(data), "func (m Messages) Greeting(arg1 string) string {\n")
+
+	assert.Equal(t, 1, run([]string{"gen", "go", "-config", cfg, "fr.json"}, nil, &stdout, &stderr))
+	assert.Equal(t, 1, run([]string{"gen", "go", "-config", cfg}, nil, &stdout, &stderr))
+	assert.Equal(t, 1, run([]string{"gen", "swift"}, nil, &stdout, &stderr))
+}
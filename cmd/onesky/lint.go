@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// runLint downloads translations of every source file from config and checks them with lint rules of config
+func runLint(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	path := fs.String("config", config.DefaultFileName, "project config file")
+	var locales listFlag
+	fs.Var(&locales, "locale", "OneSky locale to check, can be repeated (default: all project languages)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, client, err := loadProject(*path)
+	if err != nil {
+		return err
+	}
+	sources, err := cfg.Sources()
+	if err != nil {
+		return err
+	}
+	codes, err := projectLocales(cfg, client, locales)
+	if err != nil {
+		return err
+	}
+
+	problems := 0
+	for _, s := range sources {
+		data, err := ioutil.ReadFile(s.Path)
+		if err != nil {
+			return err
+		}
+		source, err := formats.ParseString(formats.Format(s.Format), string(data))
+		if err != nil {
+			return fmt.Errorf("%s: %s", s.Path, err)
+		}
+		source.Locale = cfg.SourceLocale
+
+		for _, code := range codes {
+			content, err := client.DownloadFile(s.Name, code)
+			if err != nil {
+				return fmt.Errorf("%s %s: %s", s.Name, code, err)
+			}
+			translation, err := formats.ParseString(formats.Format(s.Format), content)
+			if err != nil {
+				return fmt.Errorf("%s %s: %s", s.Name, code, err)
+			}
+			translation.Locale = code
+
+			for _, v := range cfg.Lint.Lint(source, translation) {
+				fmt.Fprintf(stdout, "%s %s: %s\n", s.Name, code, v)
+				problems++
+			}
+		}
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("%d problems found", problems)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintCommand(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en"},{"code":"de"},{"code":"fr"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("locale") == "de" {
+			return httpmock.NewStringResponse(200, `{"title": "Titel", "greeting": "Hallo %d"}`), nil
+		}
+		return httpmock.NewStringResponse(200, `{"title": "Titre", "greeting": "Bonjour %s"}`), nil
+	})
+
+	dir, err := ioutil.TempDir("", "onesky")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := filepath.Join(dir, ".onesky.yml")
+	assert.Nil(t, ioutil.WriteFile(cfg, []byte("project_id: 1\nsource_locale: en\noutput: \"{locale}/{file}\"\nfiles:\n  - source: en.json\n    format: HIERARCHICAL_JSON\nlint:\n  forbidden_terms: [Titre]\n"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "en.json"), []byte(`{"title": "Title", "greeting": "Hello %s"}`), 0644))
+
+	os.Setenv("ONESKY_API_KEY", "abcdef")
+	os.Setenv("ONESKY_SECRET", "abcdef")
+	defer os.Unsetenv("ONESKY_API_KEY")
+	defer os.Unsetenv("ONESKY_SECRET")
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"lint", "-config", cfg}, nil, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, `en.json de: greeting: placeholders: placeholder 1 is %s in source but %d in translation
+en.json fr: title: forbidden-term: translation contains forbidden term "Titre"
+`, stdout.String())
+	assert.Equal(t, "onesky lint: 2 problems found\n", stderr.String())
+
+	stdout.Reset()
+	code = run([]string{"lint", "-config", cfg, "-locale", "en"}, nil, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "", stdout.String())
+
+	os.Unsetenv("ONESKY_SECRET")
+	assert.Equal(t, 1, run([]string{"lint", "-config", cfg}, nil, &stdout, &stderr))
+}
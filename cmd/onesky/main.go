@@ -0,0 +1,153 @@
+// Command onesky is a command line tool for working with OneSky translation files
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/config"
+)
+
+// command is a single subcommand of onesky tool
+type command struct {
+	usage string
+	run   func(args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+var commands = map[string]command{
+	"convert":  command{usage: "convert files between formats", run: runConvert},
+	"gen":      command{usage: "generate typed accessors of messages", run: runGen},
+	"lint":     command{usage: "check quality of translations downloaded from OneSky", run: runLint},
+	"pseudo":   command{usage: "generate pseudo-localized file", run: runPseudo},
+	"snapshot": command{usage: "download all translations into bundle directory", run: runSnapshot},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes subcommand and returns exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "onesky: unknown command %s\n", args[0])
+		usage(stderr)
+		return 2
+	}
+	if err := cmd.run(args[1:], stdin, stdout, stderr); err != nil {
+		fmt.Fprintf(stderr, "onesky %s: %s\n", args[0], err)
+		return 1
+	}
+
+	return 0
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: onesky <command> [arguments]")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].usage)
+	}
+}
+
+// loadProject loads project config and returns client of project, credentials are read from
+// ONESKY_API_KEY and ONESKY_SECRET environment variables
+func loadProject(path string) (*config.Config, *onesky.Client, error) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := &onesky.Client{APIKey: os.Getenv("ONESKY_API_KEY"), Secret: os.Getenv("ONESKY_SECRET"), ProjectID: cfg.ProjectID}
+	if client.APIKey == "" || client.Secret == "" {
+		return nil, nil, fmt.Errorf("ONESKY_API_KEY and ONESKY_SECRET environment variables are required")
+	}
+	if client.Cache, err = loadCache(); err != nil {
+		return nil, nil, err
+	}
+
+	return cfg, client, nil
+}
+
+// loadCache returns download cache configured by ONESKY_CACHE_DIR, ONESKY_CACHE_TTL and ONESKY_OFFLINE
+// environment variables, it is nil when ONESKY_CACHE_DIR is not set
+func loadCache() (*onesky.Cache, error) {
+	dir := os.Getenv("ONESKY_CACHE_DIR")
+	if dir == "" {
+		return nil, nil
+	}
+
+	cache := &onesky.Cache{Dir: dir}
+	if ttl := os.Getenv("ONESKY_CACHE_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("ONESKY_CACHE_TTL: %s", err)
+		}
+		cache.TTL = d
+	}
+	if offline := os.Getenv("ONESKY_OFFLINE"); offline != "" {
+		b, err := strconv.ParseBool(offline)
+		if err != nil {
+			return nil, fmt.Errorf("ONESKY_OFFLINE: %s", err)
+		}
+		cache.Offline = b
+	}
+
+	return cache, nil
+}
+
+// projectLocales returns OneSky locales of project without source locale, list is used when it is not empty
+func projectLocales(cfg *config.Config, client *onesky.Client, list []string) ([]string, error) {
+	if len(list) > 0 {
+		return list, nil
+	}
+
+	languages, err := client.GetLanguages()
+	if err != nil {
+		return nil, err
+	}
+	var locales []string
+	for _, l := range languages {
+		if l.Code != cfg.SourceLocale {
+			locales = append(locales, l.Code)
+		}
+	}
+
+	return locales, nil
+}
+
+// listFlag is a flag which can be repeated or given as comma separated list
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+
+	return nil
+}
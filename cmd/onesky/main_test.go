@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 2, run(nil, nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "convert")
+
+	stderr.Reset()
+	assert.Equal(t, 2, run([]string{"unknown"}, nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "unknown command unknown")
+
+	stderr.Reset()
+	assert.Equal(t, 1, run([]string{"convert"}, nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "onesky convert: -from and -to are required")
+}
+
+func TestLoadCache(t *testing.T) {
+	cache, err := loadCache()
+	assert.Nil(t, err)
+	assert.Nil(t, cache)
+
+	os.Setenv("ONESKY_CACHE_DIR", "/tmp/onesky")
+	os.Setenv("ONESKY_CACHE_TTL", "10m")
+	os.Setenv("ONESKY_OFFLINE", "true")
+	defer os.Unsetenv("ONESKY_CACHE_DIR")
+	defer os.Unsetenv("ONESKY_CACHE_TTL")
+	defer os.Unsetenv("ONESKY_OFFLINE")
+	cache, err = loadCache()
+	assert.Nil(t, err)
+	assert.Equal(t, &onesky.Cache{Dir: "/tmp/onesky", TTL: 10 * time.Minute, Offline: true}, cache)
+
+	os.Setenv("ONESKY_CACHE_TTL", "often")
+	_, err = loadCache()
+	assert.NotNil(t, err)
+}
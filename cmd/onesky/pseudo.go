@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/pseudo"
+)
+
+// runPseudo pseudo-localizes file given as argument or stdin and writes it in the same format to -o file or stdout
+func runPseudo(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("pseudo", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "", "format of file, e.g. GNU_PO")
+	output := fs.String("o", "", "output file (default: stdout)")
+	rtl := fs.Bool("rtl", false, "generate right-to-left variant")
+	expansion := fs.Int("expansion", -1, "percentage of text length added as padding (default: 30, 0 with -rtl)")
+	locale := fs.String("locale", "", "locale of generated file (default: en-XA, ar-XB with -rtl)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: onesky pseudo -format FORMAT [flags] [file]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format == "" || fs.NArg() > 1 {
+		fs.Usage()
+		return fmt.Errorf("-format is required")
+	}
+
+	opts := pseudo.DefaultOptions
+	if *rtl {
+		opts = pseudo.RTLOptions
+	}
+	if *expansion >= 0 {
+		opts.Expansion = *expansion
+	}
+	if *locale != "" {
+		opts.Locale = *locale
+	}
+
+	in := stdin
+	if fs.NArg() == 1 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	c, err := formats.Parse(formats.Format(*format), in)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := formats.Write(formats.Format(*format), &b, pseudo.Catalog(c, opts)); err != nil {
+		return err
+	}
+	if *output != "" {
+		return ioutil.WriteFile(*output, b.Bytes(), 0644)
+	}
+	_, err = b.WriteTo(stdout)
+
+	return err
+}
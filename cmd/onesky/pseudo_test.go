@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPseudoCommand(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader(`{"title": "Hello %s", "n": {"one": "{{count}} file", "other": "{{count}} files"}}`)
+	code := run([]string{"pseudo", "-format", "HIERARCHICAL_JSON"}, stdin, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "{\n  \"title\": \"[Ĥéļļö %s o]\",\n  \"n\": {\n    \"one\": \"[{{count}} ƒîļé o]\",\n    \"other\": \"[{{count}} ƒîļéš o]\"\n  }\n}\n", stdout.String())
+
+	dir, err := ioutil.TempDir("", "onesky")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	in := filepath.Join(dir, "en.yml")
+	out := filepath.Join(dir, "ar-XB.yml")
+	assert.Nil(t, ioutil.WriteFile(in, []byte("en:\n  hi: Hi\n"), 0644))
+
+	code = run([]string{"pseudo", "-format", "RUBY_YAML", "-rtl", "-o", out, in}, nil, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	data, err := ioutil.ReadFile(out)
+	assert.Nil(t, err)
+	assert.Equal(t, "ar-XB:\n  hi: \u200f\u202eHi\u202c\u200f\n", string(data))
+
+	code = run([]string{"pseudo", in}, nil, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/SebastianCzoch/onesky-go/provider"
+	"github.com/SebastianCzoch/onesky-go/snapshot"
+)
+
+// runSnapshot downloads all files of project config in all locales into bundle directory with manifest
+func runSnapshot(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("snapshot", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	path := fs.String("config", config.DefaultFileName, "project config file")
+	output := fs.String("o", "snapshot", "bundle directory, it is replaced by new bundle")
+	version := fs.String("version", "", "version of bundle (default: time of creation)")
+	var locales listFlag
+	fs.Var(&locales, "locale", "locales to download, can be repeated (default: all languages of project)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: onesky snapshot [flags]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, client, err := loadProject(*path)
+	if err != nil {
+		return err
+	}
+	files, err := provider.ConfigFiles(cfg)
+	if err != nil {
+		return err
+	}
+
+	m, err := snapshot.Create(client, *output, snapshot.Options{Files: files, Locales: locales, SourceLocale: cfg.SourceLocale, Version: *version})
+	if err != nil {
+		return err
+	}
+	for _, l := range m.Locales {
+		fmt.Fprintf(stdout, "%-10s %s\n", l.Code, l.Progress)
+	}
+	fmt.Fprintf(stdout, "snapshot %s: %d files in %d locales written to %s\n", m.Version, len(m.Files), len(m.Locales), *output)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotCommand(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en","translation_progress":"100.0%"},{"code":"de","translation_progress":"40.0%"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", httpmock.NewStringResponder(200, `{"title": "Title"}`))
+
+	dir, err := ioutil.TempDir("", "onesky")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := filepath.Join(dir, ".onesky.yml")
+	assert.Nil(t, ioutil.WriteFile(cfg, []byte("project_id: 1\nsource_locale: en\noutput: \"{locale}/{file}\"\nfiles:\n  - source: en.json\n    format: HIERARCHICAL_JSON\n"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "en.json"), []byte(`{}`), 0644))
+
+	os.Setenv("ONESKY_API_KEY", "abcdef")
+	os.Setenv("ONESKY_SECRET", "abcdef")
+	defer os.Unsetenv("ONESKY_API_KEY")
+	defer os.Unsetenv("ONESKY_SECRET")
+
+	var stdout, stderr bytes.Buffer
+	out := filepath.Join(dir, "bundle")
+	code := run([]string{"snapshot", "-config", cfg, "-o", out, "-version", "v2", "-locale", "de"}, nil, &stdout, &stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	assert.Equal(t, "de         40.0%\nsnapshot v2: 1 files in 1 locales written to "+out+"\n", stdout.String())
+	_, err = os.Stat(filepath.Join(out, "manifest.json"))
+	assert.Nil(t, err)
+	_, err = os.Stat(filepath.Join(out, "de", "en.json"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, run([]string{"snapshot", "-config", filepath.Join(dir, "missing.yml")}, nil, &stdout, &stderr))
+}
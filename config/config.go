@@ -0,0 +1,278 @@
+// Package config loads the declarative .onesky.yml project file used by push and pull automation
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/lint"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultFileName is the name of project config file looked up by tools
+const DefaultFileName = ".onesky.yml"
+
+// Formats is a list of file formats accepted by OneSky upload API
+var Formats = []string{
+	"IOS_STRINGS",
+	"IOS_STRINGSDICT_XML",
+	"GNU_PO",
+	"GNU_POT",
+	"ANDROID_XML",
+	"ANDROID_JSON",
+	"JAVA_PROPERTIES",
+	"RUBY_YML",
+	"RUBY_YAML",
+	"FLASH_XML",
+	"RRC",
+	"RESX",
+	"RESW",
+	"RESJSON",
+	"HIERARCHICAL_JSON",
+	"PHP",
+	"PHP_SHORT_ARRAY",
+	"PHP_VARIABLES",
+	"HTML",
+	"YML",
+	"YAML",
+	"ADEMPIERE_XML",
+	"IDEMPIERE_XML",
+	"QT_TS_XML",
+	"XLIFF",
+	"TMX",
+	"L10N",
+	"INI",
+	"REQUIREJS",
+}
+
+// Config is a struct which contains mappings between local files and files stored in OneSky service
+type Config struct {
+	ProjectID     int               `yaml:"project_id"`
+	SourceLocale  string            `yaml:"source_locale"`
+	LocaleAliases map[string]string `yaml:"locale_aliases"`
+	Output        string            `yaml:"output"`
+	Files         []File            `yaml:"files"`
+	Protect       []string          `yaml:"protect"`
+	Lint          lint.Config       `yaml:"lint"`
+	Fallback      fallback.Config   `yaml:"fallback"`
+
+	// Dir is a directory against which globs and output paths are resolved, Load sets it to directory of config file
+	Dir string `yaml:"-"`
+}
+
+// File is a struct which maps local source files matched by glob to OneSky file name and format
+type File struct {
+	Source      string `yaml:"source"`
+	Name        string `yaml:"name"`
+	Format      string `yaml:"format"`
+	Output      string `yaml:"output"`
+	KeepStrings *bool  `yaml:"keep_strings"`
+}
+
+// Source is a struct which contains informations about single local source file resolved from config
+type Source struct {
+	Path        string
+	Name        string
+	Format      string
+	Output      string
+	KeepStrings bool
+}
+
+// Load reads, parses and validates config file
+func Load(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	c.Dir = filepath.Dir(path)
+
+	return c, nil
+}
+
+// Parse parses and validates config from YAML document
+func Parse(data []byte) (*Config, error) {
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, err
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Validate checks whether config is complete and consistent
+func (c *Config) Validate() error {
+	if c.ProjectID < 0 {
+		return fmt.Errorf("invalid project_id: %d", c.ProjectID)
+	}
+
+	if len(c.Files) == 0 {
+		return fmt.Errorf("no files defined")
+	}
+
+	seen := map[string]string{}
+	for code, alias := range c.LocaleAliases {
+		if code == "" || alias == "" {
+			return fmt.Errorf("empty locale alias: %q: %q", code, alias)
+		}
+		if other, ok := seen[alias]; ok {
+			return fmt.Errorf("locale alias %s used for both %s and %s", alias, other, code)
+		}
+		seen[alias] = code
+	}
+
+	for _, pattern := range c.Protect {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid protect pattern %s: %s", pattern, err)
+		}
+	}
+
+	for i, f := range c.Files {
+		if f.Source == "" {
+			return fmt.Errorf("files[%d]: source is required", i)
+		}
+		if _, err := filepath.Match(f.Source, ""); err != nil {
+			return fmt.Errorf("files[%d]: invalid source pattern %s: %s", i, f.Source, err)
+		}
+		if !isKnownFormat(f.Format) {
+			return fmt.Errorf("files[%d]: unknown format %q", i, f.Format)
+		}
+		output := c.outputFor(f)
+		if output == "" {
+			return fmt.Errorf("files[%d]: output is required", i)
+		}
+		if !strings.Contains(output, "{locale}") {
+			return fmt.Errorf("files[%d]: output %s does not contain {locale}", i, output)
+		}
+	}
+
+	return nil
+}
+
+// Sources resolves globs from config to list of local source files
+func (c *Config) Sources() ([]Source, error) {
+	var sources []Source
+	names := map[string]string{}
+	for _, f := range c.Files {
+		matches, err := filepath.Glob(c.path(f.Source))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(matches)
+
+		for _, match := range matches {
+			name := filepath.Base(match)
+			if f.Name != "" {
+				name = ExpandPath(f.Name, "", name)
+			}
+			if other, ok := names[name]; ok {
+				return nil, fmt.Errorf("file name %s used for both %s and %s", name, other, match)
+			}
+			names[name] = match
+
+			keepStrings := true
+			if f.KeepStrings != nil {
+				keepStrings = *f.KeepStrings
+			}
+
+			sources = append(sources, Source{
+				Path:        match,
+				Name:        name,
+				Format:      f.Format,
+				Output:      c.outputFor(f),
+				KeepStrings: keepStrings,
+			})
+		}
+	}
+
+	return sources, nil
+}
+
+// LocalLocale returns local name of OneSky locale code, code is returned when there is no alias
+func (c *Config) LocalLocale(code string) string {
+	if alias, ok := c.LocaleAliases[code]; ok {
+		return alias
+	}
+
+	return code
+}
+
+// OneSkyLocale returns OneSky locale code for local locale name, it is reverse of LocalLocale
+func (c *Config) OneSkyLocale(local string) string {
+	for code, alias := range c.LocaleAliases {
+		if alias == local {
+			return code
+		}
+	}
+
+	return local
+}
+
+// Fallbacks returns fallback chains of project, source locale is the default fallback when it is not configured
+func (c *Config) Fallbacks() fallback.Config {
+	f := c.Fallback
+	if f.Default == "" {
+		f.Default = c.SourceLocale
+	}
+
+	return f
+}
+
+// OutputPath returns local path of translation of source file for OneSky locale code
+func (c *Config) OutputPath(s Source, code string) string {
+	return c.path(ExpandPath(s.Output, c.LocalLocale(code), s.Name))
+}
+
+// ExpandPath replaces {locale}, {file}, {name} and {ext} placeholders in path template
+func ExpandPath(template, locale, file string) string {
+	ext := filepath.Ext(file)
+	r := strings.NewReplacer(
+		"{locale}", locale,
+		"{file}", file,
+		"{name}", strings.TrimSuffix(file, ext),
+		"{ext}", strings.TrimPrefix(ext, "."),
+	)
+
+	return r.Replace(template)
+}
+
+func (c *Config) outputFor(f File) string {
+	if f.Output != "" {
+		return f.Output
+	}
+
+	return c.Output
+}
+
+func (c *Config) path(p string) string {
+	if c.Dir == "" || filepath.IsAbs(p) {
+		return p
+	}
+
+	return filepath.Join(c.Dir, p)
+}
+
+func isKnownFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
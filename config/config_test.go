@@ -0,0 +1,141 @@
+// Package config tests
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfig = `
+project_id: 1
+source_locale: en-US
+locale_aliases:
+  zh-TW: zh_Hant
+output: locales/{locale}/{file}
+files:
+  - source: src/*.json
+    format: HIERARCHICAL_JSON
+  - source: src/messages.yml
+    name: web-{name}.{ext}
+    format: YAML
+    output: config/{name}.{locale}.{ext}
+    keep_strings: false
+protect:
+  - legacy-*.yml
+lint:
+  max_length_increase: 50
+  locales:
+    de:
+      punctuation: false
+fallback:
+  chains:
+    pt-BR: [pt-PT]
+`
+
+func TestLoadWithSuccess(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	os.Mkdir(path.Join(tmpdir, "src"), 0777)
+	ioutil.WriteFile(path.Join(tmpdir, "src", "b.json"), []byte("{}"), 0666)
+	ioutil.WriteFile(path.Join(tmpdir, "src", "a.json"), []byte("{}"), 0666)
+	ioutil.WriteFile(path.Join(tmpdir, "src", "messages.yml"), []byte("a: b"), 0666)
+	ioutil.WriteFile(path.Join(tmpdir, DefaultFileName), []byte(testConfig), 0666)
+
+	c, err := Load(path.Join(tmpdir, DefaultFileName))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, c.ProjectID)
+	assert.Equal(t, tmpdir, c.Dir)
+	assert.Equal(t, []string{"legacy-*.yml"}, c.Protect)
+	assert.Equal(t, 50, *c.Lint.For("de").MaxLengthIncrease)
+	assert.False(t, *c.Lint.For("de").Punctuation)
+	assert.Equal(t, []string{"pt-PT", "en-US"}, c.Fallbacks().Chain("pt-BR"))
+	assert.Equal(t, []string{"de", "en-US"}, c.Fallbacks().Chain("de-AT"))
+
+	sources, err := c.Sources()
+	assert.Nil(t, err)
+	assert.Equal(t, []Source{
+		Source{
+			Path:        path.Join(tmpdir, "src", "a.json"),
+			Name:        "a.json",
+			Format:      "HIERARCHICAL_JSON",
+			Output:      "locales/{locale}/{file}",
+			KeepStrings: true,
+		},
+		Source{
+			Path:        path.Join(tmpdir, "src", "b.json"),
+			Name:        "b.json",
+			Format:      "HIERARCHICAL_JSON",
+			Output:      "locales/{locale}/{file}",
+			KeepStrings: true,
+		},
+		Source{
+			Path:        path.Join(tmpdir, "src", "messages.yml"),
+			Name:        "web-messages.yml",
+			Format:      "YAML",
+			Output:      "config/{name}.{locale}.{ext}",
+			KeepStrings: false,
+		},
+	}, sources)
+
+	assert.Equal(t, path.Join(tmpdir, "locales", "zh_Hant", "a.json"), c.OutputPath(sources[0], "zh-TW"))
+	assert.Equal(t, path.Join(tmpdir, "config", "web-messages.de.yml"), c.OutputPath(sources[2], "de"))
+}
+
+func TestLoadWithFailure(t *testing.T) {
+	_, err := Load("not_exist.yml")
+	assert.NotNil(t, err)
+}
+
+func TestParseWithFailure(t *testing.T) {
+	cases := map[string]string{
+		"unknown field":   "files: []\nunknown: 1",
+		"no files":        "project_id: 1",
+		"no source":       "output: '{locale}'\nfiles:\n  - format: YAML",
+		"unknown format":  "output: '{locale}'\nfiles:\n  - source: a.yml\n    format: DOCX",
+		"no output":       "files:\n  - source: a.yml\n    format: YAML",
+		"no locale":       "output: a/{file}\nfiles:\n  - source: a.yml\n    format: YAML",
+		"bad pattern":     "output: '{locale}'\nfiles:\n  - source: '[a'\n    format: YAML",
+		"bad protect":     "output: '{locale}'\nprotect: ['[a']\nfiles:\n  - source: a.yml\n    format: YAML",
+		"duplicate alias": "output: '{locale}'\nlocale_aliases: {zh-TW: zh, zh-CN: zh}\nfiles:\n  - source: a.yml\n    format: YAML",
+	}
+
+	for name, data := range cases {
+		_, err := Parse([]byte(data))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestSourcesWithDuplicateName(t *testing.T) {
+	c := &Config{
+		Output: "{locale}/{file}",
+		Files: []File{
+			File{Source: "config.go", Name: "same.go", Format: "YAML"},
+			File{Source: "config_test.go", Name: "same.go", Format: "YAML"},
+		},
+	}
+
+	_, err := c.Sources()
+	assert.NotNil(t, err)
+}
+
+func TestLocaleAliases(t *testing.T) {
+	c := &Config{LocaleAliases: map[string]string{"zh-TW": "zh_Hant"}}
+
+	assert.Equal(t, "zh_Hant", c.LocalLocale("zh-TW"))
+	assert.Equal(t, "de", c.LocalLocale("de"))
+	assert.Equal(t, "zh-TW", c.OneSkyLocale("zh_Hant"))
+	assert.Equal(t, "de", c.OneSkyLocale("de"))
+}
+
+func TestExpandPath(t *testing.T) {
+	assert.Equal(t, "locales/de/app.json", ExpandPath("locales/{locale}/{file}", "de", "app.json"))
+	assert.Equal(t, "app.de.json", ExpandPath("{name}.{locale}.{ext}", "de", "app.json"))
+}
@@ -0,0 +1,106 @@
+// Package diff compares local source catalogs with translations downloaded from OneSky key by key
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package diff
+
+import (
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// Report is a struct which contains differences between source catalog and translations of single locale
+type Report struct {
+	Locale string `json:"locale"`
+	// Added are keys of source which are missing in translations
+	Added []Entry `json:"added"`
+	// Removed are keys of translations which do not exist in source anymore
+	Removed []Entry `json:"removed"`
+	// Changed are keys which source text changed since translations were made
+	Changed []Entry `json:"changed"`
+	// Untranslated are keys which exist in translations with empty text
+	Untranslated []Entry `json:"untranslated"`
+}
+
+// Entry is a struct which contains informations about single differing key
+type Entry struct {
+	Key         string `json:"key"`
+	Context     string `json:"context,omitempty"`
+	Source      string `json:"source,omitempty"`
+	OldSource   string `json:"old_source,omitempty"`
+	Translation string `json:"translation,omitempty"`
+}
+
+// Compare compares local source catalog with translations of locale. Base is a source catalog downloaded
+// from OneSky which translations were made from, changed source texts are reported only when it is not nil.
+func Compare(source, base, translation *formats.Catalog) *Report {
+	r := &Report{Locale: translation.Locale, Added: []Entry{}, Removed: []Entry{}, Changed: []Entry{}, Untranslated: []Entry{}}
+	for _, m := range source.Messages {
+		e := Entry{Key: m.Key, Context: m.Context, Source: text(m)}
+
+		if base != nil {
+			if old, ok := base.Lookup(m.Context, m.Key); ok && !equal(old, m) {
+				changed := e
+				changed.OldSource = text(old)
+				if t, ok := translation.Lookup(m.Context, m.Key); ok {
+					changed.Translation = text(t)
+				}
+				r.Changed = append(r.Changed, changed)
+			}
+		}
+
+		t, ok := translation.Lookup(m.Context, m.Key)
+		switch {
+		case !ok:
+			r.Added = append(r.Added, e)
+		case isEmpty(t):
+			r.Untranslated = append(r.Untranslated, e)
+		}
+	}
+
+	for _, m := range translation.Messages {
+		if _, ok := source.Lookup(m.Context, m.Key); !ok {
+			r.Removed = append(r.Removed, Entry{Key: m.Key, Context: m.Context, Translation: text(m)})
+		}
+	}
+
+	return r
+}
+
+// Empty returns whether report contains no differences
+func (r *Report) Empty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0 && len(r.Untranslated) == 0
+}
+
+// text returns text of message, "other" form is used for plural messages
+func text(m *formats.Message) string {
+	if m.IsPlural() {
+		return m.Plural[formats.Other]
+	}
+
+	return m.Value
+}
+
+func isEmpty(m *formats.Message) bool {
+	if !m.IsPlural() {
+		return m.Value == ""
+	}
+	for _, v := range m.Plural {
+		if v != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equal(a, b *formats.Message) bool {
+	if a.Value != b.Value || len(a.Plural) != len(b.Plural) {
+		return false
+	}
+	for category, v := range a.Plural {
+		if b.Plural[category] != v {
+			return false
+		}
+	}
+
+	return true
+}
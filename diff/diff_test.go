@@ -0,0 +1,60 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/stretchr/testify/assert"
+)
+
+func catalog(locale string, messages ...*formats.Message) *formats.Catalog {
+	c := formats.NewCatalog(locale)
+	for _, m := range messages {
+		c.Add(m)
+	}
+
+	return c
+}
+
+func TestCompare(t *testing.T) {
+	source := catalog("en",
+		&formats.Message{Key: "title", Value: "Welcome!"},
+		&formats.Message{Key: "new", Value: "New"},
+		&formats.Message{Key: "empty", Value: "Empty"},
+		&formats.Message{Key: "items", Plural: map[string]string{formats.One: "%d item", formats.Other: "%d items"}},
+		&formats.Message{Key: "open", Context: "menu", Value: "Open"},
+	)
+	base := catalog("en",
+		&formats.Message{Key: "title", Value: "Welcome"},
+		&formats.Message{Key: "empty", Value: "Empty"},
+		&formats.Message{Key: "items", Plural: map[string]string{formats.Other: "%d items"}},
+	)
+	translation := catalog("de",
+		&formats.Message{Key: "title", Value: "Willkommen"},
+		&formats.Message{Key: "empty", Value: ""},
+		&formats.Message{Key: "items", Plural: map[string]string{formats.One: "", formats.Other: ""}},
+		&formats.Message{Key: "open", Context: "menu", Value: "Öffnen"},
+		&formats.Message{Key: "old", Value: "Alt"},
+	)
+
+	r := Compare(source, base, translation)
+	assert.Equal(t, &Report{
+		Locale:  "de",
+		Added:   []Entry{Entry{Key: "new", Source: "New"}},
+		Removed: []Entry{Entry{Key: "old", Translation: "Alt"}},
+		Changed: []Entry{
+			Entry{Key: "title", Source: "Welcome!", OldSource: "Welcome", Translation: "Willkommen"},
+			Entry{Key: "items", Source: "%d items", OldSource: "%d items"},
+		},
+		Untranslated: []Entry{
+			Entry{Key: "empty", Source: "Empty"},
+			Entry{Key: "items", Source: "%d items"},
+		},
+	}, r)
+	assert.False(t, r.Empty())
+
+	r = Compare(source, nil, translation)
+	assert.Equal(t, []Entry{}, r.Changed)
+
+	assert.True(t, Compare(source, source, source).Empty())
+}
@@ -0,0 +1,67 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteText writes human readable summary of reports, e.g. for pull request comments
+func WriteText(w io.Writer, reports ...*Report) error {
+	for i, r := range reports {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		_, err := fmt.Fprintf(w, "%s: %d added, %d removed, %d changed, %d untranslated\n",
+			r.Locale, len(r.Added), len(r.Removed), len(r.Changed), len(r.Untranslated))
+		if err != nil {
+			return err
+		}
+
+		sections := []struct {
+			mark    string
+			entries []Entry
+			line    func(e Entry) string
+		}{
+			{"+", r.Added, func(e Entry) string { return strconv.Quote(e.Source) }},
+			{"-", r.Removed, func(e Entry) string { return strconv.Quote(e.Translation) }},
+			{"~", r.Changed, func(e Entry) string { return strconv.Quote(e.OldSource) + " -> " + strconv.Quote(e.Source) }},
+			{"?", r.Untranslated, func(e Entry) string { return strconv.Quote(e.Source) }},
+		}
+		for _, s := range sections {
+			for _, e := range s.entries {
+				if _, err := fmt.Fprintf(w, "  %s %s: %s\n", s.mark, key(e), s.line(e)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes reports as JSON array
+func WriteJSON(w io.Writer, reports ...*Report) error {
+	if reports == nil {
+		reports = []*Report{}
+	}
+	data, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+func key(e Entry) string {
+	if e.Context == "" {
+		return e.Key
+	}
+
+	return e.Context + "|" + e.Key
+}
@@ -0,0 +1,67 @@
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testReports() []*Report {
+	return []*Report{
+		&Report{
+			Locale:       "de",
+			Added:        []Entry{Entry{Key: "new", Source: "New"}},
+			Removed:      []Entry{Entry{Key: "old", Context: "menu", Translation: "Alt"}},
+			Changed:      []Entry{Entry{Key: "title", Source: "Welcome!", OldSource: "Welcome", Translation: "Willkommen"}},
+			Untranslated: []Entry{Entry{Key: "empty", Source: "Say \"hi\""}},
+		},
+		&Report{Locale: "fr", Added: []Entry{}, Removed: []Entry{}, Changed: []Entry{}, Untranslated: []Entry{}},
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var b bytes.Buffer
+	err := WriteText(&b, testReports()...)
+	assert.Nil(t, err)
+	assert.Equal(t, `de: 1 added, 1 removed, 1 changed, 1 untranslated
+  + new: "New"
+  - menu|old: "Alt"
+  ~ title: "Welcome" -> "Welcome!"
+  ? empty: "Say \"hi\""
+
+fr: 0 added, 0 removed, 0 changed, 0 untranslated
+`, b.String())
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	err := WriteJSON(&b, testReports()[1])
+	assert.Nil(t, err)
+	assert.Equal(t, `[
+  {
+    "locale": "fr",
+    "added": [],
+    "removed": [],
+    "changed": [],
+    "untranslated": []
+  }
+]
+`, b.String())
+
+	b.Reset()
+	err = WriteJSON(&b, testReports()[0])
+	assert.Nil(t, err)
+	assert.Contains(t, b.String(), `"removed": [
+      {
+        "key": "old",
+        "context": "menu",
+        "translation": "Alt"
+      }
+    ]`)
+
+	b.Reset()
+	err = WriteJSON(&b)
+	assert.Nil(t, err)
+	assert.Equal(t, "[]\n", b.String())
+}
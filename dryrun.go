@@ -0,0 +1,54 @@
+package onesky
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// authParams are query parameters which are not logged in dry run mode
+var authParams = []string{"api_key", "timestamp", "dev_hash"}
+
+// dryRunUpload logs upload request and returns synthetic upload data with completed import
+func (c *Client) dryRunUpload(method, urlStr, file, name, fileFormat, locale string) (UploadData, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return UploadData{}, err
+	}
+
+	c.logDryRun(method, urlStr, fmt.Sprintf("file=%s size=%d", name, info.Size()))
+
+	return UploadData{
+		Name:     filepath.Base(name),
+		Format:   fileFormat,
+		Language: Language{Code: locale},
+		Import:   TaskData{Status: ImportCompleted},
+	}, nil
+}
+
+// logDryRun logs request which would be sent without authorization parameters
+func (c *Client) logDryRun(method, urlStr, extra string) {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return
+	}
+
+	v := u.Query()
+	for _, p := range authParams {
+		v.Del(p)
+	}
+	u.RawQuery = v.Encode()
+
+	msg := fmt.Sprintf("dry run: %s %s", method, u.String())
+	if extra != "" {
+		msg += " " + extra
+	}
+
+	if c.Logger != nil {
+		c.Logger.Println(msg)
+		return
+	}
+	log.Println(msg)
+}
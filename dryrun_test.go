@@ -0,0 +1,79 @@
+package onesky
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"regexp"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadFileWithDryRun(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var out bytes.Buffer
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1, DryRun: true, Logger: log.New(&out, "", 0)}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	filename := path.Join(tmpdir, "string.po")
+	ioutil.WriteFile(filename, []byte("test"), 0666)
+
+	res, err := client.UploadFile(filename, "GNU_PO", "en_US", true)
+	assert.Nil(t, err)
+	assert.Equal(t, UploadData{
+		Name:     "string.po",
+		Format:   "GNU_PO",
+		Language: Language{Code: "en_US"},
+		Import:   TaskData{Status: ImportCompleted},
+	}, res)
+	assert.Equal(t, "dry run: POST https://platform.api.onesky.io/1/projects/1/files?file_format=GNU_PO&is_keeping_all_strings=true&locale=en_US file="+filename+" size=4\n", out.String())
+
+	_, err = client.UploadFile(path.Join(tmpdir, "not_found"), "GNU_PO", "en_US", true)
+	assert.NotNil(t, err)
+}
+
+func TestDeleteFileWithDryRun(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var out bytes.Buffer
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1, DryRun: true, Logger: log.New(&out, "", 0)}
+
+	err := client.DeleteFile("test.yml")
+	assert.Nil(t, err)
+	assert.Equal(t, "dry run: DELETE https://platform.api.onesky.io/1/projects/1/files?file_name=test.yml\n", out.String())
+}
+
+func TestPushWithDryRun(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/files", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[]}`))
+	var out bytes.Buffer
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1, DryRun: true, Logger: log.New(&out, "", 0)}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	ioutil.WriteFile(path.Join(tmpdir, "a.yml"), []byte("a"), 0666)
+	res, err := client.Push(context.Background(), PushOptions{
+		Sources:  []config.Source{config.Source{Path: path.Join(tmpdir, "a.yml"), Name: "web-a.yml", Format: "YAML"}},
+		Locale:   "en",
+		Manifest: path.Join(tmpdir, "manifest.json"),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, res.Uploaded(), 1)
+	assert.Regexp(t, regexp.MustCompile(`file=web-a\.yml size=1`), out.String())
+
+	_, err = os.Stat(path.Join(tmpdir, "manifest.json"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,73 @@
+// Package fallback fills missing translations of locale from its fallback locales
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package fallback
+
+import (
+	"strings"
+)
+
+// Config is a struct which contains fallback chains of locales
+type Config struct {
+	// Chains maps locale to its fallback locales in order of preference, e.g. pt-BR: [pt-PT, en].
+	// Locales without chain fall back to their CLDR parents.
+	Chains map[string][]string `yaml:"chains"`
+	// Default is a locale appended to every chain, usually source locale
+	Default string `yaml:"default"`
+}
+
+// parents are CLDR parent locales which differ from truncation of last subtag, root is empty string
+var parents = map[string]string{
+	"en-AU": "en-GB", "en-BE": "en-GB", "en-HK": "en-GB", "en-IE": "en-GB", "en-IN": "en-GB", "en-MT": "en-GB",
+	"en-NZ": "en-GB", "en-PK": "en-GB", "en-SG": "en-GB", "en-ZA": "en-GB",
+	"es-AR": "es-419", "es-BO": "es-419", "es-CL": "es-419", "es-CO": "es-419", "es-CR": "es-419", "es-CU": "es-419",
+	"es-DO": "es-419", "es-EC": "es-419", "es-GT": "es-419", "es-HN": "es-419", "es-MX": "es-419", "es-NI": "es-419",
+	"es-PA": "es-419", "es-PE": "es-419", "es-PR": "es-419", "es-PY": "es-419", "es-SV": "es-419", "es-US": "es-419",
+	"es-UY": "es-419", "es-VE": "es-419",
+	"pt-AO": "pt-PT", "pt-CH": "pt-PT", "pt-CV": "pt-PT", "pt-GQ": "pt-PT", "pt-GW": "pt-PT", "pt-LU": "pt-PT",
+	"pt-MO": "pt-PT", "pt-MZ": "pt-PT", "pt-ST": "pt-PT", "pt-TL": "pt-PT",
+	"zh-TW": "zh-Hant", "zh-HK": "zh-Hant", "zh-MO": "zh-HK", "zh-Hant-HK": "zh-Hant", "zh-Hant-MO": "zh-Hant-HK",
+	"zh-CN": "zh-Hans", "zh-SG": "zh-Hans", "zh-Hans-SG": "zh-Hans",
+	"nb": "no", "nn": "no",
+	// traditional Chinese does not fall back to simplified one
+	"zh-Hant": "",
+}
+
+// Parent returns CLDR parent of locale, e.g. es-419 for es-MX and pt for pt-BR. Empty string is returned for
+// root locales. Both - and _ are accepted as subtag separators.
+func Parent(locale string) string {
+	if p, ok := parents[strings.Replace(locale, "_", "-", -1)]; ok {
+		return p
+	}
+	if i := strings.LastIndexAny(locale, "-_"); i > 0 {
+		return locale[:i]
+	}
+
+	return ""
+}
+
+// Chain returns fallback locales of locale in order of preference, locale itself is not included.
+// Configured chain is used when it exists, otherwise CLDR parents are used, default locale is always the last one.
+func (c Config) Chain(locale string) []string {
+	var chain []string
+	seen := map[string]bool{locale: true}
+	add := func(l string) {
+		if l != "" && !seen[l] {
+			seen[l] = true
+			chain = append(chain, l)
+		}
+	}
+
+	if configured, ok := c.Chains[locale]; ok {
+		for _, l := range configured {
+			add(l)
+		}
+	} else {
+		for p := Parent(locale); p != ""; p = Parent(p) {
+			add(p)
+		}
+	}
+	add(c.Default)
+
+	return chain
+}
@@ -0,0 +1,31 @@
+package fallback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParent(t *testing.T) {
+	assert.Equal(t, "pt", Parent("pt-BR"))
+	assert.Equal(t, "pt-PT", Parent("pt-AO"))
+	assert.Equal(t, "es-419", Parent("es-MX"))
+	assert.Equal(t, "es", Parent("es-419"))
+	assert.Equal(t, "en-GB", Parent("en_AU"))
+	assert.Equal(t, "zh-Hant", Parent("zh-TW"))
+	assert.Equal(t, "", Parent("zh-Hant"))
+	assert.Equal(t, "sr-Latn", Parent("sr-Latn-RS"))
+	assert.Equal(t, "", Parent("en"))
+	assert.Equal(t, "", Parent(""))
+}
+
+func TestChain(t *testing.T) {
+	c := Config{Chains: map[string][]string{"pt-BR": []string{"pt-PT", "pt-BR", "en"}, "ja": []string{}}, Default: "en"}
+	assert.Equal(t, []string{"pt-PT", "en"}, c.Chain("pt-BR"))
+	assert.Equal(t, []string{"es-419", "es", "en"}, c.Chain("es-MX"))
+	assert.Equal(t, []string{"zh-HK", "zh-Hant", "en"}, c.Chain("zh-MO"))
+	assert.Equal(t, []string{"en"}, c.Chain("ja"))
+	assert.Equal(t, []string{"en-GB", "en"}, c.Chain("en-AU"))
+	assert.Equal(t, []string(nil), c.Chain("en"))
+	assert.Equal(t, []string{"pt"}, Config{}.Chain("pt-BR"))
+}
@@ -0,0 +1,127 @@
+package fallback
+
+import (
+	"fmt"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// Report is a struct which contains informations about messages of merged catalog which are not translated in its locale
+type Report struct {
+	Locale string `json:"locale"`
+	// Fallbacks are messages taken from fallback locales
+	Fallbacks []Entry `json:"fallbacks"`
+	// Missing are messages which are not translated in any locale of chain, they are left out of merged catalog
+	Missing []Entry `json:"missing"`
+}
+
+// Entry is a struct which contains informations about single message taken from fallback locale
+type Entry struct {
+	Key     string `json:"key"`
+	Context string `json:"context,omitempty"`
+	// Locale is a fallback locale which message was taken from, it is empty for missing messages
+	Locale string `json:"locale,omitempty"`
+}
+
+// ByLocale returns keys of messages taken from fallback locales grouped by locale
+func (r *Report) ByLocale() map[string][]string {
+	keys := map[string][]string{}
+	for _, e := range r.Fallbacks {
+		keys[e.Locale] = append(keys[e.Locale], e.Key)
+	}
+
+	return keys
+}
+
+// Merge returns catalog of locale in which messages missing or untranslated in locale are filled from first
+// locale of its fallback chain which translates them. Catalogs are mapped by locale, e.g. parsed output of
+// DownloadFile, locales of chain without catalog are skipped. Messages of locale keep their order and messages
+// which exist only in fallback catalogs are appended in order of chain.
+func (c Config) Merge(locale string, catalogs map[string]*formats.Catalog) (*formats.Catalog, *Report) {
+	out := formats.NewCatalog(locale)
+	if own, ok := catalogs[locale]; ok {
+		out.Header = append([]formats.HeaderField{}, own.Header...)
+		out.HeaderComments, out.HeaderFlags = own.HeaderComments, own.HeaderFlags
+	}
+	r := &Report{Locale: locale, Fallbacks: []Entry{}, Missing: []Entry{}}
+
+	type link struct {
+		locale  string
+		catalog *formats.Catalog
+	}
+	var chain []link
+	for _, l := range append([]string{locale}, c.Chain(locale)...) {
+		if catalog, ok := catalogs[l]; ok {
+			chain = append(chain, link{locale: l, catalog: catalog})
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, current := range chain {
+		for _, m := range current.catalog.Messages {
+			id := m.Context + "\x04" + m.Key
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			found := false
+			for _, fallback := range chain[i:] {
+				f, ok := fallback.catalog.Lookup(m.Context, m.Key)
+				if !ok || !translated(f) {
+					continue
+				}
+				cp := *f
+				out.Add(&cp)
+				if fallback.locale != locale {
+					r.Fallbacks = append(r.Fallbacks, Entry{Key: m.Key, Context: m.Context, Locale: fallback.locale})
+				}
+				found = true
+				break
+			}
+			if !found {
+				r.Missing = append(r.Missing, Entry{Key: m.Key, Context: m.Context})
+			}
+		}
+	}
+
+	return out, r
+}
+
+// Download downloads file in every locale with download function, e.g. (*onesky.Client).DownloadFile, and parses
+// it in format, catalogs are mapped by locale. Locales have to be languages of project, e.g. locale and its chain
+// filtered by GetLanguages.
+func Download(download func(fileName, locale string) (string, error), fileName string, format formats.Format, locales []string) (map[string]*formats.Catalog, error) {
+	catalogs := map[string]*formats.Catalog{}
+	for _, locale := range locales {
+		content, err := download(fileName, locale)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", locale, err)
+		}
+		catalog, err := formats.ParseString(format, content)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", locale, err)
+		}
+		catalog.Locale = locale
+		catalogs[locale] = catalog
+	}
+
+	return catalogs, nil
+}
+
+// translated returns whether message has translation, plural message needs all its forms
+func translated(m *formats.Message) bool {
+	if !m.IsPlural() {
+		return m.Value != ""
+	}
+	if len(m.Plural) == 0 {
+		return false
+	}
+	for _, v := range m.Plural {
+		if v == "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,71 @@
+package fallback
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	en, err := formats.ParseString(formats.HierarchicalJSON, `{"title": "Title", "save": "Save", "items": {"one": "1 item", "other": "{{count}} items"}, "new": "New", "empty": ""}`)
+	assert.Nil(t, err)
+	pt, err := formats.ParseString(formats.HierarchicalJSON, `{"title": "Título", "save": "Guardar", "items": {"one": "1 item", "other": "{{count}} itens"}}`)
+	assert.Nil(t, err)
+	br, err := formats.ParseString(formats.HierarchicalJSON, `{"save": "Salvar", "title": "", "items": {"one": "1 item", "other": ""}, "extra": "Extra"}`)
+	assert.Nil(t, err)
+	br.HeaderComments = []string{"header"}
+
+	c := Config{Chains: map[string][]string{"pt-BR": []string{"pt-PT"}}, Default: "en"}
+	merged, r := c.Merge("pt-BR", map[string]*formats.Catalog{"pt-BR": br, "pt-PT": pt, "en": en})
+	assert.Equal(t, "pt-BR", merged.Locale)
+	assert.Equal(t, []string{"header"}, merged.HeaderComments)
+	assert.Equal(t, []string{"save", "title", "items", "extra", "new"}, merged.Keys())
+
+	m, _ := merged.Get("save")
+	assert.Equal(t, "Salvar", m.Value)
+	m, _ = merged.Get("title")
+	assert.Equal(t, "Título", m.Value)
+	m, _ = merged.Get("items")
+	assert.Equal(t, map[string]string{formats.One: "1 item", formats.Other: "{{count}} itens"}, m.Plural)
+	m, _ = merged.Get("new")
+	assert.Equal(t, "New", m.Value)
+
+	assert.Equal(t, "pt-BR", r.Locale)
+	assert.Equal(t, []Entry{
+		Entry{Key: "title", Locale: "pt-PT"},
+		Entry{Key: "items", Locale: "pt-PT"},
+		Entry{Key: "new", Locale: "en"},
+	}, r.Fallbacks)
+	assert.Equal(t, []Entry{Entry{Key: "empty"}}, r.Missing)
+	assert.Equal(t, map[string][]string{"pt-PT": []string{"title", "items"}, "en": []string{"new"}}, r.ByLocale())
+
+	// source catalog is modified neither by merge nor by changes of merged catalog
+	m.Value = "changed"
+	m, _ = en.Get("new")
+	assert.Equal(t, "New", m.Value)
+
+	merged, r = c.Merge("pt-BR", map[string]*formats.Catalog{"en": en})
+	assert.Equal(t, []string{"title", "save", "items", "new"}, merged.Keys())
+	assert.Equal(t, 4, len(r.Fallbacks))
+}
+
+func TestDownload(t *testing.T) {
+	download := func(fileName, locale string) (string, error) {
+		if locale == "xx" {
+			return "", fmt.Errorf("unknown locale")
+		}
+		return fmt.Sprintf(`{"%s": "%s"}`, fileName, locale), nil
+	}
+
+	catalogs, err := Download(download, "title", formats.HierarchicalJSON, []string{"pt-BR", "en"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(catalogs))
+	m, _ := catalogs["pt-BR"].Get("title")
+	assert.Equal(t, "pt-BR", m.Value)
+	assert.Equal(t, "en", catalogs["en"].Locale)
+
+	_, err = Download(download, "title", formats.HierarchicalJSON, []string{"en", "xx"})
+	assert.Equal(t, "xx: unknown locale", err.Error())
+}
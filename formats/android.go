@@ -0,0 +1,255 @@
+package formats
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// AndroidXML is a format of Android strings.xml resources
+const AndroidXML Format = "ANDROID_XML"
+
+// AndroidCodec is a codec of Android strings.xml files, items of <string-array> are stored as "name[index]" keys
+type AndroidCodec struct{}
+
+// androidNonTranslatable is a flag of strings with translatable="false" attribute
+const androidNonTranslatable = "non-translatable"
+
+var androidArrayKey = regexp.MustCompile(`^(.+)\[(\d+)\]$`)
+
+type androidItem struct {
+	Quantity string `xml:"quantity,attr"`
+	Inner    string `xml:",innerxml"`
+}
+
+type androidElement struct {
+	Name         string        `xml:"name,attr"`
+	Translatable string        `xml:"translatable,attr"`
+	Inner        string        `xml:",innerxml"`
+	Items        []androidItem `xml:"item"`
+}
+
+// Decode parses strings.xml file, comments preceding element are attached to its messages
+func (a *AndroidCodec) Decode(r io.Reader) (*Catalog, error) {
+	dec := xml.NewDecoder(r)
+	c := NewCatalog("")
+	var comments []string
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.Comment:
+			comments = append(comments, strings.TrimSpace(string(t)))
+		case xml.EndElement:
+			depth--
+		case xml.StartElement:
+			if depth == 0 {
+				if t.Name.Local != "resources" {
+					return nil, fmt.Errorf("android: expected <resources>, got <%s>", t.Name.Local)
+				}
+				depth++
+				continue
+			}
+
+			e := androidElement{}
+			if err := dec.DecodeElement(&e, &t); err != nil {
+				return nil, err
+			}
+			var flags []string
+			if e.Translatable == "false" {
+				flags = []string{androidNonTranslatable}
+			}
+
+			switch t.Name.Local {
+			case "string":
+				c.Add(&Message{Key: e.Name, Value: decodeAndroidValue(e.Inner), Comments: comments, Flags: flags})
+			case "plurals":
+				m := &Message{Key: e.Name, Plural: map[string]string{}, Comments: comments, Flags: flags}
+				for _, item := range e.Items {
+					if !isPluralCategory(item.Quantity) {
+						return nil, fmt.Errorf("android: plurals %s has invalid quantity %q", e.Name, item.Quantity)
+					}
+					m.Plural[item.Quantity] = decodeAndroidValue(item.Inner)
+				}
+				c.Add(m)
+			case "string-array":
+				for i, item := range e.Items {
+					m := &Message{Key: fmt.Sprintf("%s[%d]", e.Name, i), Value: decodeAndroidValue(item.Inner), Flags: flags}
+					if i == 0 {
+						m.Comments = comments
+					}
+					c.Add(m)
+				}
+			}
+			comments = nil
+		}
+	}
+
+	return c, nil
+}
+
+// Encode writes catalog as strings.xml file
+func (a *AndroidCodec) Encode(w io.Writer, c *Catalog) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<resources>\n")
+
+	for i := 0; i < len(c.Messages); i++ {
+		m := c.Messages[i]
+		for _, comment := range m.Comments {
+			fmt.Fprintf(bw, "    <!-- %s -->\n", strings.Replace(comment, "--", "- -", -1))
+		}
+		attrs := ""
+		if m.HasFlag(androidNonTranslatable) {
+			attrs = ` translatable="false"`
+		}
+
+		if match := androidArrayKey.FindStringSubmatch(m.Key); match != nil && match[2] == "0" && !m.IsPlural() {
+			fmt.Fprintf(bw, "    <string-array name=\"%s\"%s>\n", escapeXMLAttr(match[1]), attrs)
+			n := 0
+			for ; i+n < len(c.Messages); n++ {
+				item := c.Messages[i+n]
+				if item.Key != fmt.Sprintf("%s[%d]", match[1], n) || item.IsPlural() {
+					break
+				}
+				fmt.Fprintf(bw, "        <item>%s</item>\n", encodeAndroidValue(item.Value))
+			}
+			i += n - 1
+			bw.WriteString("    </string-array>\n")
+			continue
+		}
+
+		if !m.IsPlural() {
+			fmt.Fprintf(bw, "    <string name=\"%s\"%s>%s</string>\n", escapeXMLAttr(m.Key), attrs, encodeAndroidValue(m.Value))
+			continue
+		}
+
+		fmt.Fprintf(bw, "    <plurals name=\"%s\"%s>\n", escapeXMLAttr(m.Key), attrs)
+		for _, category := range PluralCategories {
+			if v, ok := m.Plural[category]; ok {
+				fmt.Fprintf(bw, "        <item quantity=\"%s\">%s</item>\n", category, encodeAndroidValue(v))
+			}
+		}
+		bw.WriteString("    </plurals>\n")
+	}
+
+	bw.WriteString("</resources>\n")
+	return bw.Flush()
+}
+
+// decodeAndroidValue converts inner XML of element to string, markup of rich text is kept as is
+func decodeAndroidValue(inner string) string {
+	s := inner
+	if !strings.Contains(inner, "<") {
+		s = unescapeXML(inner)
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			if i+4 < len(s) {
+				if r, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					b.WriteRune(rune(r))
+					i += 4
+					continue
+				}
+			}
+			b.WriteByte('u')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
+
+// encodeAndroidValue escapes string for strings.xml, tags of well-formed markup are written as is
+func encodeAndroidValue(s string) string {
+	r := strings.NewReplacer("\\", "\\\\", "'", "\\'", "\"", "\\\"", "\n", "\\n", "\t", "\\t")
+	prefix := ""
+	if strings.HasPrefix(s, "@") || strings.HasPrefix(s, "?") {
+		prefix = "\\"
+	}
+
+	if !hasMarkup(s) {
+		return prefix + escapeXMLText(r.Replace(s))
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range markupTag.FindAllStringIndex(s, -1) {
+		b.WriteString(r.Replace(s[last:loc[0]]))
+		b.WriteString(s[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	b.WriteString(r.Replace(s[last:]))
+
+	return prefix + b.String()
+}
+
+var markupTag = regexp.MustCompile(`<[a-zA-Z/][^>]*>`)
+
+// hasMarkup returns whether string contains tags and is well-formed XML fragment
+func hasMarkup(s string) bool {
+	if !markupTag.MatchString(s) {
+		return false
+	}
+
+	dec := xml.NewDecoder(strings.NewReader("<x>" + s + "</x>"))
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			return true
+		}
+		if err != nil {
+			return false
+		}
+	}
+}
+
+func escapeXMLText(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func escapeXMLAttr(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;").Replace(s)
+}
+
+func unescapeXML(s string) string {
+	var b strings.Builder
+	dec := xml.NewDecoder(strings.NewReader("<x>" + s + "</x>"))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+		if data, ok := tok.(xml.CharData); ok {
+			b.Write(data)
+		}
+	}
+
+	return b.String()
+}
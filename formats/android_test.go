@@ -0,0 +1,73 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testAndroid = `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <!-- Application name -->
+    <string name="app_name" translatable="false">My App</string>
+    <string name="welcome">Don\'t say \"hi\" &amp; leave\nnow</string>
+    <string name="rich">Hello <b>%1$s</b>\'s friend</string>
+    <string name="reference">\@string/app_name</string>
+    <plurals name="items">
+        <item quantity="one">%d item</item>
+        <item quantity="other">%d items</item>
+    </plurals>
+    <string-array name="days">
+        <item>Mon</item>
+        <item>Tue</item>
+    </string-array>
+</resources>
+`
+
+func TestAndroidDecode(t *testing.T) {
+	c, err := ParseString(AndroidXML, testAndroid)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Message{
+		&Message{Key: "app_name", Value: "My App", Comments: []string{"Application name"}, Flags: []string{"non-translatable"}},
+		&Message{Key: "welcome", Value: "Don't say \"hi\" & leave\nnow"},
+		&Message{Key: "rich", Value: "Hello <b>%1$s</b>'s friend"},
+		&Message{Key: "reference", Value: "@string/app_name"},
+		&Message{Key: "items", Plural: map[string]string{One: "%d item", Other: "%d items"}},
+		&Message{Key: "days[0]", Value: "Mon"},
+		&Message{Key: "days[1]", Value: "Tue"},
+	}, c.Messages)
+
+	_, err = ParseString(AndroidXML, `<plist></plist>`)
+	assert.NotNil(t, err)
+	_, err = ParseString(AndroidXML, `<resources><plurals name="a"><item quantity="some">a</item></plurals></resources>`)
+	assert.NotNil(t, err)
+	_, err = ParseString(AndroidXML, `<resources><string name="a">a</resources>`)
+	assert.NotNil(t, err)
+}
+
+func TestAndroidRoundTrip(t *testing.T) {
+	c, err := ParseString(AndroidXML, testAndroid)
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	err = Write(AndroidXML, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, testAndroid, b.String())
+}
+
+func TestAndroidEncodeMarkup(t *testing.T) {
+	c := NewCatalog("en")
+	c.Add(&Message{Key: "link", Value: `Read <a href="https://example.com">"terms"</a>`})
+	c.Add(&Message{Key: "compare", Value: "1 < 2"})
+
+	var b bytes.Buffer
+	err := Write(AndroidXML, &b, c)
+	assert.Nil(t, err)
+	assert.Contains(t, b.String(), `<string name="link">Read <a href="https://example.com">\"terms\"</a></string>`)
+	assert.Contains(t, b.String(), `<string name="compare">1 &lt; 2</string>`)
+
+	back, err := ParseString(AndroidXML, b.String())
+	assert.Nil(t, err)
+	assert.Equal(t, c.Messages, back.Messages)
+}
@@ -0,0 +1,226 @@
+// Package formats parses OneSky translation files into common Catalog model and writes them back
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package formats
+
+// CLDR plural categories
+const (
+	Zero  = "zero"
+	One   = "one"
+	Two   = "two"
+	Few   = "few"
+	Many  = "many"
+	Other = "other"
+)
+
+// PluralCategories is a list of CLDR plural categories in canonical order
+var PluralCategories = []string{Zero, One, Two, Few, Many, Other}
+
+// Catalog is a struct which contains ordered messages of single locale. Add and Remove update index of messages
+// immediately, messages appended to Messages directly are indexed by next Add and searched one by one until then.
+// Reindex has to be called after messages in Messages were replaced or removed directly. Lookup and Get never
+// modify catalog, so they are safe for concurrent use when catalog is not modified.
+type Catalog struct {
+	Locale   string
+	Messages []*Message
+
+	// Header contains file header fields, e.g. PO header entry
+	Header         []HeaderField
+	HeaderComments []string
+	HeaderFlags    []string
+
+	index map[string]int
+	// indexed is a number of leading messages in index
+	indexed int
+}
+
+// HeaderField is a struct which contains single name and value of file header
+type HeaderField struct {
+	Name  string
+	Value string
+}
+
+// Message is a struct which contains single translatable string
+type Message struct {
+	Key      string
+	Context  string
+	Value    string
+	Plural   map[string]string
+	Comments []string
+
+	// PluralKey is a source text of plural form, e.g. msgid_plural of PO file
+	PluralKey string
+	// ExtractedComments are comments for translators extracted from source code
+	ExtractedComments []string
+	// References are source code locations of message
+	References []string
+	// Flags are format specific markers, e.g. fuzzy or c-format
+	Flags []string
+	// Previous are previous source texts of fuzzy message
+	Previous []string
+	// Obsolete marks message which is not used in sources anymore
+	Obsolete bool
+
+	// Source is a source language text of message in bilingual files, e.g. XLIFF
+	Source string
+	// SourcePlural are source language plural forms of message in bilingual files
+	SourcePlural map[string]string
+}
+
+// NewCatalog returns empty catalog of locale
+func NewCatalog(locale string) *Catalog {
+	return &Catalog{Locale: locale, index: map[string]int{}}
+}
+
+// IsPlural returns whether message has plural forms
+func (m *Message) IsPlural() bool {
+	return len(m.Plural) > 0 || len(m.SourcePlural) > 0 || m.PluralKey != ""
+}
+
+// HasFlag returns whether message is marked with flag
+func (m *Message) HasFlag(flag string) bool {
+	for _, f := range m.Flags {
+		if f == flag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFuzzy returns whether message is marked as fuzzy
+func (m *Message) IsFuzzy() bool {
+	return m.HasFlag("fuzzy")
+}
+
+// HeaderValue returns value of header field, empty string is returned when field does not exist
+func (c *Catalog) HeaderValue(name string) string {
+	for _, f := range c.Header {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+
+	return ""
+}
+
+// SetHeader sets value of header field, new field is appended at the end of header
+func (c *Catalog) SetHeader(name, value string) {
+	for i, f := range c.Header {
+		if f.Name == name {
+			c.Header[i].Value = value
+			return
+		}
+	}
+
+	c.Header = append(c.Header, HeaderField{Name: name, Value: value})
+}
+
+// Add appends message to catalog, message with the same key and context is replaced
+func (c *Catalog) Add(m *Message) {
+	if c.index == nil || c.indexed > len(c.Messages) {
+		c.Reindex()
+	}
+	for ; c.indexed < len(c.Messages); c.indexed++ {
+		n := c.Messages[c.indexed]
+		c.index[messageID(n.Context, n.Key)] = c.indexed
+	}
+
+	id := messageID(m.Context, m.Key)
+	if i, ok := c.find(id); ok {
+		c.Messages[i] = m
+		return
+	}
+
+	c.index[id] = len(c.Messages)
+	c.Messages = append(c.Messages, m)
+	c.indexed = len(c.Messages)
+}
+
+// Get returns message without context by key
+func (c *Catalog) Get(key string) (*Message, bool) {
+	return c.Lookup("", key)
+}
+
+// Lookup returns message by context and key
+func (c *Catalog) Lookup(context, key string) (*Message, bool) {
+	i, ok := c.find(messageID(context, key))
+	if !ok {
+		return nil, false
+	}
+
+	return c.Messages[i], true
+}
+
+// Remove deletes message by context and key
+func (c *Catalog) Remove(context, key string) {
+	i, ok := c.find(messageID(context, key))
+	if !ok {
+		return
+	}
+
+	c.Messages = append(c.Messages[:i], c.Messages[i+1:]...)
+	c.Reindex()
+}
+
+// Keys returns keys of all messages in catalog order
+func (c *Catalog) Keys() []string {
+	keys := make([]string, 0, len(c.Messages))
+	for _, m := range c.Messages {
+		keys = append(keys, m.Key)
+	}
+
+	return keys
+}
+
+// Len returns number of messages in catalog
+func (c *Catalog) Len() int {
+	return len(c.Messages)
+}
+
+// Reindex rebuilds index of messages, it has to be called after messages in Messages were replaced or removed directly
+func (c *Catalog) Reindex() {
+	c.index = make(map[string]int, len(c.Messages))
+	for i, m := range c.Messages {
+		c.index[messageID(m.Context, m.Key)] = i
+	}
+	c.indexed = len(c.Messages)
+}
+
+// find returns position of message by id without modifying catalog. Index is used only when it points to message
+// with id, messages which are not indexed yet are searched one by one.
+func (c *Catalog) find(id string) (int, bool) {
+	if i, ok := c.index[id]; ok && i < len(c.Messages) && messageID(c.Messages[i].Context, c.Messages[i].Key) == id {
+		return i, true
+	}
+
+	from := c.indexed
+	if from > len(c.Messages) {
+		from = 0
+	}
+	for i := len(c.Messages) - 1; i >= from; i-- {
+		if m := c.Messages[i]; messageID(m.Context, m.Key) == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func messageID(context, key string) string {
+	if context == "" {
+		return key
+	}
+
+	return context + "\x04" + key
+}
+
+func isPluralCategory(s string) bool {
+	for _, c := range PluralCategories {
+		if c == s {
+			return true
+		}
+	}
+
+	return false
+}
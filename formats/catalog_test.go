@@ -0,0 +1,77 @@
+// Package formats tests
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package formats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	c := NewCatalog("en")
+	c.Add(&Message{Key: "a", Value: "A"})
+	c.Add(&Message{Key: "b", Value: "B"})
+	c.Add(&Message{Key: "a", Context: "menu", Value: "Menu A"})
+	c.Add(&Message{Key: "a", Value: "AA"})
+
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, []string{"a", "b", "a"}, c.Keys())
+
+	m, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "AA", m.Value)
+
+	m, ok = c.Lookup("menu", "a")
+	assert.True(t, ok)
+	assert.Equal(t, "Menu A", m.Value)
+
+	c.Remove("", "a")
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	m, ok = c.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, "B", m.Value)
+
+	c.Messages = append(c.Messages, &Message{Key: "c", Plural: map[string]string{One: "1", Other: "n"}})
+	m, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.True(t, m.IsPlural())
+}
+
+func TestCatalogWithoutIndex(t *testing.T) {
+	c := &Catalog{Messages: []*Message{&Message{Key: "a", Value: "A"}, &Message{Key: "a", Context: "menu", Value: "Menu A"}}}
+	m, ok := c.Lookup("menu", "a")
+	assert.True(t, ok)
+	assert.Equal(t, "Menu A", m.Value)
+	assert.Nil(t, c.index)
+
+	c.Add(&Message{Key: "a", Value: "AA"})
+	assert.Equal(t, []string{"a", "a"}, c.Keys())
+	m, _ = c.Get("a")
+	assert.Equal(t, "AA", m.Value)
+}
+
+func TestCatalogReindex(t *testing.T) {
+	c := NewCatalog("en")
+	c.Add(&Message{Key: "a", Value: "A"})
+	c.Add(&Message{Key: "b", Value: "B"})
+
+	// message replaced directly is not found by stale index until Reindex, but stale index never returns other message
+	c.Messages[0] = &Message{Key: "d", Value: "D"}
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	_, ok = c.Get("d")
+	assert.False(t, ok)
+	c.Reindex()
+	m, ok := c.Get("d")
+	assert.True(t, ok)
+	assert.Equal(t, "D", m.Value)
+
+	c.Remove("", "d")
+	assert.Equal(t, []string{"b"}, c.Keys())
+	m, ok = c.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, "B", m.Value)
+}
@@ -0,0 +1,211 @@
+package formats
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Reasons of lossy conversion
+const (
+	LossPluralFlattened = "plural forms flattened into separate keys"
+	LossDropped         = "message not supported by format"
+	LossComments        = "comments dropped"
+	LossContext         = "context dropped"
+	LossSource          = "source text dropped"
+	LossExtracted       = "extracted comments dropped"
+	LossReferences      = "references dropped"
+	LossFlags           = "flags dropped"
+	LossPrevious        = "previous source texts dropped"
+	LossObsolete        = "obsolete mark dropped"
+)
+
+// Loss is a struct which contains information about message which could not be converted without loss
+type Loss struct {
+	Key    string
+	Reason string
+}
+
+// String returns loss as "key: reason"
+func (l Loss) String() string {
+	return fmt.Sprintf("%s: %s", l.Key, l.Reason)
+}
+
+// Converter converts files between formats, zero value uses separators of registered codecs
+type Converter struct {
+	// Separator is used to flatten and unflatten nested keys of hierarchical formats and plural forms
+	// written into formats without plurals
+	Separator string
+	// Locale is set as locale of converted catalog, e.g. for RUBY_YAML or GNU_PO
+	Locale string
+	// Strict makes Convert fail instead of writing lossy conversion
+	Strict bool
+}
+
+// formatFeatures describes which parts of catalog can be written in format
+type formatFeatures struct {
+	plural    bool
+	nonPlural bool
+	comments  bool
+	context   bool
+	source    bool
+	// gettext are extracted comments, references, previous source texts and obsolete messages
+	gettext bool
+	// flags are prefixes of flags written in format, empty prefix matches all flags
+	flags []string
+}
+
+var (
+	allFlags           = []string{""}
+	xliffFlags         = []string{xliffStateFlag}
+	goI18nFlagPrefixes = []string{goI18nHashFlag, goI18nLeftDelimFlag, goI18nRightDelimFlag}
+)
+
+var features = map[Format]formatFeatures{
+	HierarchicalJSON: formatFeatures{plural: true, nonPlural: true},
+	YAML:             formatFeatures{plural: true, nonPlural: true},
+	YML:              formatFeatures{plural: true, nonPlural: true},
+	RubyYAML:         formatFeatures{plural: true, nonPlural: true},
+	RubyYML:          formatFeatures{plural: true, nonPlural: true},
+	JavaProperties:   formatFeatures{nonPlural: true, comments: true},
+	IOSStrings:       formatFeatures{nonPlural: true, comments: true},
+	GNUPO:            formatFeatures{plural: true, nonPlural: true, comments: true, context: true, gettext: true, flags: allFlags},
+	GNUPOT:           formatFeatures{plural: true, nonPlural: true, comments: true, context: true, gettext: true, flags: allFlags},
+	AndroidXML:       formatFeatures{plural: true, nonPlural: true, comments: true, flags: []string{androidNonTranslatable}},
+	IOSStringsdict:   formatFeatures{plural: true, flags: []string{stringsdictVariableFlag, stringsdictValueTypeFlag}},
+	XLIFF:            formatFeatures{plural: true, nonPlural: true, comments: true, source: true, flags: xliffFlags},
+	XLIFF2:           formatFeatures{plural: true, nonPlural: true, comments: true, source: true, flags: xliffFlags},
+	GoI18nJSON:       formatFeatures{plural: true, nonPlural: true, comments: true, flags: goI18nFlagPrefixes},
+	GoI18nTOML:       formatFeatures{plural: true, nonPlural: true, comments: true, flags: goI18nFlagPrefixes},
+}
+
+// keepsFlags returns whether all flags of message are written in format
+func (ff formatFeatures) keepsFlags(m *Message) bool {
+	for _, flag := range m.Flags {
+		kept := false
+		for _, prefix := range ff.flags {
+			if strings.HasPrefix(flag, prefix) {
+				kept = true
+				break
+			}
+		}
+		if !kept {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Convert converts file from src format to dst format using default Converter
+func Convert(src, dst Format, r io.Reader, w io.Writer) ([]Loss, error) {
+	return (&Converter{}).Convert(src, dst, r, w)
+}
+
+// Convert decodes file in src format and writes it in dst format. Messages which can not be written
+// without loss are returned, plural messages are written as "key.category" keys into formats without plurals.
+func (cv *Converter) Convert(src, dst Format, r io.Reader, w io.Writer) ([]Loss, error) {
+	decoder, err := Lookup(src)
+	if err != nil {
+		return nil, err
+	}
+	encoder, err := Lookup(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := cv.codec(decoder).Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	if cv.Locale != "" {
+		c.Locale = cv.Locale
+	}
+
+	converted, losses := cv.adapt(c, dst)
+	if cv.Strict && len(losses) > 0 {
+		return losses, fmt.Errorf("conversion from %s to %s is lossy: %s", src, dst, losses[0])
+	}
+
+	return losses, cv.codec(encoder).Encode(w, converted)
+}
+
+// adapt returns copy of catalog which can be written in format and list of losses
+func (cv *Converter) adapt(c *Catalog, f Format) (*Catalog, []Loss) {
+	ff, ok := features[f]
+	if !ok {
+		return c, nil
+	}
+
+	separator := cv.Separator
+	if separator == "" {
+		separator = "."
+	}
+
+	var losses []Loss
+	out := NewCatalog(c.Locale)
+	out.Header, out.HeaderComments, out.HeaderFlags = c.Header, c.HeaderComments, c.HeaderFlags
+	for _, m := range c.Messages {
+		if len(m.Comments) > 0 && !ff.comments {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossComments})
+		}
+		if m.Context != "" && !ff.context {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossContext})
+		}
+		if (m.Source != "" || len(m.SourcePlural) > 0) && !ff.source {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossSource})
+		}
+		if len(m.ExtractedComments) > 0 && !ff.gettext {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossExtracted})
+		}
+		if len(m.References) > 0 && !ff.gettext {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossReferences})
+		}
+		if !ff.keepsFlags(m) {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossFlags})
+		}
+		if len(m.Previous) > 0 && !ff.gettext {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossPrevious})
+		}
+		if m.Obsolete && !ff.gettext {
+			losses = append(losses, Loss{Key: m.Key, Reason: LossObsolete})
+		}
+
+		plural := m.IsPlural()
+		switch {
+		case plural && !ff.plural && ff.nonPlural:
+			losses = append(losses, Loss{Key: m.Key, Reason: LossPluralFlattened})
+			for _, category := range PluralCategories {
+				if v, ok := m.Plural[category]; ok {
+					out.Add(&Message{Key: m.Key + separator + category, Value: v, Comments: m.Comments})
+				}
+			}
+		case plural && !ff.plural, !plural && !ff.nonPlural:
+			losses = append(losses, Loss{Key: m.Key, Reason: LossDropped})
+		default:
+			out.Add(m)
+		}
+	}
+
+	return out, losses
+}
+
+// codec returns codec with separator of converter
+func (cv *Converter) codec(c Codec) Codec {
+	if cv.Separator == "" {
+		return c
+	}
+
+	switch v := c.(type) {
+	case *JSONCodec:
+		cp := *v
+		cp.Separator = cv.Separator
+		return &cp
+	case *YAMLCodec:
+		cp := *v
+		cp.Separator = cv.Separator
+		return &cp
+	}
+
+	return c
+}
@@ -0,0 +1,113 @@
+package formats
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	var b bytes.Buffer
+	losses, err := Convert(HierarchicalJSON, JavaProperties, strings.NewReader(`{"menu": {"open": "Open", "items": {"one": "%d item", "other": "%d items"}}}`), &b)
+	assert.Nil(t, err)
+	assert.Equal(t, []Loss{Loss{Key: "menu.items", Reason: LossPluralFlattened}}, losses)
+	assert.Equal(t, "menu.open=Open\nmenu.items.one=%d item\nmenu.items.other=%d items\n", b.String())
+
+	// flattened plural forms are nested again
+	var back bytes.Buffer
+	losses, err = Convert(JavaProperties, HierarchicalJSON, &b, &back)
+	assert.Nil(t, err)
+	assert.Nil(t, losses)
+	assert.Equal(t, `{
+  "menu": {
+    "open": "Open",
+    "items": {
+      "one": "%d item",
+      "other": "%d items"
+    }
+  }
+}
+`, back.String())
+}
+
+func TestConverterSeparatorAndLocale(t *testing.T) {
+	cv := &Converter{Separator: "/", Locale: "en"}
+	var b bytes.Buffer
+	losses, err := cv.Convert(JavaProperties, RubyYAML, strings.NewReader("# Title\nhome/title=Home\nhome/items/other=Items\n"), &b)
+	assert.Nil(t, err)
+	assert.Equal(t, []Loss{Loss{Key: "home/title", Reason: LossComments}}, losses)
+	assert.Equal(t, "en:\n  home:\n    title: Home\n    items:\n      other: Items\n", b.String())
+
+	b.Reset()
+	losses, err = cv.Convert(RubyYAML, IOSStrings, strings.NewReader("en:\n  home:\n    title: Home\n"), &b)
+	assert.Nil(t, err)
+	assert.Nil(t, losses)
+	assert.Equal(t, "\"home/title\" = \"Home\";\n", b.String())
+}
+
+func TestConverterLosses(t *testing.T) {
+	po := `msgctxt "menu"
+msgid "Open"
+msgstr "Open"
+
+msgid "item"
+msgid_plural "items"
+msgstr[0] "item"
+msgstr[1] "items"
+`
+	var b bytes.Buffer
+	losses, err := Convert(GNUPO, IOSStringsdict, strings.NewReader(po), &b)
+	assert.Nil(t, err)
+	assert.Equal(t, []Loss{
+		Loss{Key: "Open", Reason: LossContext},
+		Loss{Key: "Open", Reason: LossDropped},
+	}, losses)
+	assert.Contains(t, b.String(), "<key>item</key>")
+
+	b.Reset()
+	losses, err = (&Converter{Strict: true}).Convert(GNUPO, HierarchicalJSON, strings.NewReader(po), &b)
+	assert.NotNil(t, err)
+	assert.Equal(t, []Loss{Loss{Key: "Open", Reason: LossContext}}, losses)
+	assert.Equal(t, "", b.String())
+	assert.Equal(t, "Open: context dropped", losses[0].String())
+
+	_, err = Convert("DOCX", GNUPO, strings.NewReader(po), &b)
+	assert.NotNil(t, err)
+	_, err = Convert(GNUPO, "DOCX", strings.NewReader(po), &b)
+	assert.NotNil(t, err)
+	_, err = Convert(HierarchicalJSON, GNUPO, strings.NewReader("[]"), &b)
+	assert.NotNil(t, err)
+}
+
+func TestConverterGettextLosses(t *testing.T) {
+	po := `#. Button label
+#: main.go:10
+#, fuzzy
+#| msgid "Opn"
+msgid "Open"
+msgstr "Open"
+
+#~ msgid "Close"
+#~ msgstr "Close"
+`
+	var b bytes.Buffer
+	losses, err := Convert(GNUPO, HierarchicalJSON, strings.NewReader(po), &b)
+	assert.Nil(t, err)
+	assert.Equal(t, []Loss{
+		Loss{Key: "Open", Reason: LossExtracted},
+		Loss{Key: "Open", Reason: LossReferences},
+		Loss{Key: "Open", Reason: LossFlags},
+		Loss{Key: "Open", Reason: LossPrevious},
+		Loss{Key: "Close", Reason: LossObsolete},
+	}, losses)
+
+	b.Reset()
+	_, err = (&Converter{Strict: true}).Convert(GNUPO, HierarchicalJSON, strings.NewReader(po), &b)
+	assert.Equal(t, "conversion from GNU_PO to HIERARCHICAL_JSON is lossy: Open: extracted comments dropped", err.Error())
+
+	losses, err = Convert(GNUPO, GNUPOT, strings.NewReader(po), &b)
+	assert.Nil(t, err)
+	assert.Nil(t, losses)
+}
@@ -0,0 +1,97 @@
+package formats
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Format is a name of OneSky file format
+type Format string
+
+// OneSky file formats supported by this package
+const (
+	HierarchicalJSON Format = "HIERARCHICAL_JSON"
+	YAML             Format = "YAML"
+	YML              Format = "YML"
+	RubyYAML         Format = "RUBY_YAML"
+	RubyYML          Format = "RUBY_YML"
+	JavaProperties   Format = "JAVA_PROPERTIES"
+	IOSStrings       Format = "IOS_STRINGS"
+)
+
+// Codec is an interface of parser and serializer of single file format
+type Codec interface {
+	Decode(r io.Reader) (*Catalog, error)
+	Encode(w io.Writer, c *Catalog) error
+}
+
+var codecs = map[Format]Codec{
+	HierarchicalJSON: &JSONCodec{Separator: "."},
+	YAML:             &YAMLCodec{Separator: "."},
+	YML:              &YAMLCodec{Separator: "."},
+	RubyYAML:         &YAMLCodec{Separator: ".", LocaleRoot: true},
+	RubyYML:          &YAMLCodec{Separator: ".", LocaleRoot: true},
+	JavaProperties:   &PropertiesCodec{},
+	IOSStrings:       &StringsCodec{},
+	GNUPO:            &POCodec{},
+	GNUPOT:           &POCodec{Template: true},
+	AndroidXML:       &AndroidCodec{},
+	IOSStringsdict:   &StringsdictCodec{},
+	XLIFF:            &XLIFFCodec{Version: "1.2"},
+	XLIFF2:           &XLIFFCodec{Version: "2.0"},
+	GoI18nJSON:       &GoI18nCodec{},
+	GoI18nTOML:       &GoI18nCodec{TOML: true},
+}
+
+// Register sets codec used for format, it replaces codec registered before
+func Register(f Format, c Codec) {
+	codecs[f] = c
+}
+
+// Lookup returns codec registered for format
+func Lookup(f Format) (Codec, error) {
+	c, ok := codecs[f]
+	if !ok {
+		return nil, fmt.Errorf("format %s not supported", f)
+	}
+
+	return c, nil
+}
+
+// Supported returns list of formats with registered codec
+func Supported() []Format {
+	list := make([]Format, 0, len(codecs))
+	for f := range codecs {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+
+	return list
+}
+
+// Parse decodes file in format into catalog
+func Parse(f Format, r io.Reader) (*Catalog, error) {
+	c, err := Lookup(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Decode(r)
+}
+
+// ParseString decodes content returned by DownloadFile into catalog
+func ParseString(f Format, content string) (*Catalog, error) {
+	return Parse(f, bytes.NewBufferString(content))
+}
+
+// Write encodes catalog into file in format
+func Write(f Format, w io.Writer, catalog *Catalog) error {
+	c, err := Lookup(f)
+	if err != nil {
+		return err
+	}
+
+	return c.Encode(w, catalog)
+}
@@ -0,0 +1,33 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	_, err := Lookup("DOCX")
+	assert.NotNil(t, err)
+
+	c, err := Lookup(HierarchicalJSON)
+	assert.Nil(t, err)
+	assert.Equal(t, &JSONCodec{Separator: "."}, c)
+
+	assert.Contains(t, Supported(), IOSStrings)
+}
+
+func TestParseStringAndWrite(t *testing.T) {
+	c, err := ParseString(JavaProperties, "a=b\n")
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	err = Write(IOSStrings, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, "\"a\" = \"b\";\n", b.String())
+
+	_, err = ParseString("DOCX", "")
+	assert.NotNil(t, err)
+	assert.NotNil(t, Write("DOCX", &b, c))
+}
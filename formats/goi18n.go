@@ -0,0 +1,329 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// go-i18n message file formats, they are not OneSky formats and are used to convert files from and to go-i18n
+const (
+	GoI18nJSON Format = "GO_I18N_JSON"
+	GoI18nTOML Format = "GO_I18N_TOML"
+)
+
+// Flag prefixes which keep go-i18n message fields without counterpart in Message
+const (
+	goI18nHashFlag       = "hash:"
+	goI18nLeftDelimFlag  = "leftdelim:"
+	goI18nRightDelimFlag = "rightdelim:"
+)
+
+// goI18nFlags maps go-i18n message fields to prefixes of flags
+var goI18nFlags = []struct{ field, prefix string }{
+	{"hash", goI18nHashFlag},
+	{"leftdelim", goI18nLeftDelimFlag},
+	{"rightdelim", goI18nRightDelimFlag},
+}
+
+// goI18nFields are reserved keys of go-i18n message objects
+var goI18nFields = map[string]bool{"id": true, "description": true, "hash": true, "leftdelim": true, "rightdelim": true}
+
+// GoI18nCodec is a codec of nicksnyder/go-i18n message files in JSON or TOML. Description of message is stored
+// in Comments, hash and template delimiters in "hash:", "leftdelim:" and "rightdelim:" flags. Nested objects which
+// are not messages are flattened with keys joined by dot.
+type GoI18nCodec struct {
+	TOML bool
+}
+
+// Decode parses go-i18n message file keeping order of messages
+func (g *GoI18nCodec) Decode(r io.Reader) (*Catalog, error) {
+	var t *tree
+	if g.TOML {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		if t, err = decodeTOML(string(data)); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if t, err = decodeJSON(r); err != nil {
+			return nil, err
+		}
+	}
+
+	c := NewCatalog("")
+	if err := goI18nMessages(c, t, ""); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// goI18nMessages adds messages of tree to catalog, prefix is a key of parent object
+func goI18nMessages(c *Catalog, t *tree, prefix string) error {
+	for _, k := range t.keys {
+		key := prefix + k
+		switch v := t.values[k].(type) {
+		case string:
+			c.Add(&Message{Key: key, Value: v})
+		case *tree:
+			if !isGoI18nMessage(v) {
+				if err := goI18nMessages(c, v, key+"."); err != nil {
+					return err
+				}
+				continue
+			}
+
+			m := &Message{Key: key}
+			if id, ok := v.values["id"].(string); ok && id != "" {
+				m.Key = id
+			}
+			if d, _ := v.values["description"].(string); d != "" {
+				m.Comments = strings.Split(d, "\n")
+			}
+			for _, f := range goI18nFlags {
+				if s, _ := v.values[f.field].(string); s != "" {
+					m.Flags = append(m.Flags, f.prefix+s)
+				}
+			}
+
+			forms := map[string]string{}
+			for _, category := range PluralCategories {
+				if s, ok := v.values[category].(string); ok {
+					forms[category] = s
+				}
+			}
+			if _, ok := forms[Other]; !ok {
+				return fmt.Errorf("message %s has no other form", m.Key)
+			}
+			if len(forms) == 1 {
+				m.Value = forms[Other]
+			} else {
+				m.Plural = forms
+			}
+			c.Add(m)
+		}
+	}
+
+	return nil
+}
+
+// isGoI18nMessage returns whether object is a message, i.e. all its keys are reserved with string values
+func isGoI18nMessage(t *tree) bool {
+	for _, k := range t.keys {
+		if _, ok := t.values[k].(string); !ok || !goI18nFields[k] && !isPluralCategory(k) {
+			return false
+		}
+	}
+
+	return len(t.keys) > 0
+}
+
+// Encode writes catalog as go-i18n message file, messages with description, hash or plural forms are written
+// as objects and other messages as strings
+func (g *GoI18nCodec) Encode(w io.Writer, c *Catalog) error {
+	seen := map[string]bool{}
+	for _, m := range c.Messages {
+		if seen[m.Key] {
+			return fmt.Errorf("duplicate key %s", m.Key)
+		}
+		seen[m.Key] = true
+	}
+
+	bw := bufio.NewWriter(w)
+	if g.TOML {
+		encodeGoI18nTOML(bw, c)
+	} else if err := encodeGoI18nJSON(bw, c); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// goI18nFieldsOf returns ordered fields of message object, nil is returned for message written as string
+func goI18nFieldsOf(m *Message) [][2]string {
+	var fields [][2]string
+	if len(m.Comments) > 0 {
+		fields = append(fields, [2]string{"description", strings.Join(m.Comments, "\n")})
+	}
+	for _, f := range goI18nFlags {
+		if v := flagValue(m, f.prefix, ""); v != "" {
+			fields = append(fields, [2]string{f.field, v})
+		}
+	}
+	if !m.IsPlural() {
+		if len(fields) == 0 {
+			return nil
+		}
+		return append(fields, [2]string{Other, m.Value})
+	}
+	for _, category := range PluralCategories {
+		if v, ok := m.Plural[category]; ok {
+			fields = append(fields, [2]string{category, v})
+		}
+	}
+
+	return fields
+}
+
+func encodeGoI18nJSON(w *bufio.Writer, c *Catalog) error {
+	if len(c.Messages) == 0 {
+		_, err := w.WriteString("{}\n")
+		return err
+	}
+
+	w.WriteString("{\n")
+	for i, m := range c.Messages {
+		w.WriteString("  ")
+		if err := encodeJSONString(w, m.Key); err != nil {
+			return err
+		}
+		w.WriteString(": ")
+
+		fields := goI18nFieldsOf(m)
+		if fields == nil {
+			if err := encodeJSONString(w, m.Value); err != nil {
+				return err
+			}
+		} else {
+			w.WriteString("{\n")
+			for j, f := range fields {
+				w.WriteString("    ")
+				encodeJSONString(w, f[0])
+				w.WriteString(": ")
+				if err := encodeJSONString(w, f[1]); err != nil {
+					return err
+				}
+				if j < len(fields)-1 {
+					w.WriteString(",")
+				}
+				w.WriteString("\n")
+			}
+			w.WriteString("  }")
+		}
+
+		if i < len(c.Messages)-1 {
+			w.WriteString(",")
+		}
+		w.WriteString("\n")
+	}
+	_, err := w.WriteString("}\n")
+
+	return err
+}
+
+// encodeGoI18nTOML writes messages written as strings first, because TOML does not allow keys after tables
+func encodeGoI18nTOML(w *bufio.Writer, c *Catalog) {
+	var tables []*Message
+	for _, m := range c.Messages {
+		if goI18nFieldsOf(m) != nil {
+			tables = append(tables, m)
+			continue
+		}
+		fmt.Fprintf(w, "%s = %s\n", tomlKey(m.Key), tomlString(m.Value))
+	}
+
+	for i, m := range tables {
+		if i > 0 || len(tables) < len(c.Messages) {
+			w.WriteString("\n")
+		}
+		fmt.Fprintf(w, "[%s]\n", tomlKey(m.Key))
+		for _, f := range goI18nFieldsOf(m) {
+			fmt.Fprintf(w, "%s = %s\n", f[0], tomlString(f[1]))
+		}
+	}
+}
+
+var tomlBareKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func tomlKey(key string) string {
+	if tomlBareKey.MatchString(key) {
+		return key
+	}
+
+	return tomlString(key)
+}
+
+// tomlString returns TOML basic string, TOML escapes are a subset of JSON ones except of \u escapes of non-BMP runes
+func tomlString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// decodeTOML parses TOML document into tree keeping order of keys, non-string values are formatted
+func decodeTOML(data string) (*tree, error) {
+	var values map[string]interface{}
+	md, err := toml.Decode(data, &values)
+	if err != nil {
+		return nil, err
+	}
+
+	root := newTree()
+	for _, key := range md.Keys() {
+		var v interface{} = values
+		for _, k := range key {
+			table, ok := v.(map[string]interface{})
+			if !ok {
+				// keys of arrays of tables are not supported
+				v = nil
+				break
+			}
+			v = table[k]
+		}
+		if _, ok := v.(map[string]interface{}); ok || v == nil {
+			continue
+		}
+
+		node := root
+		for _, k := range key[:len(key)-1] {
+			sub, ok := node.values[k].(*tree)
+			if !ok {
+				sub = newTree()
+				node.set(k, sub)
+			}
+			node = sub
+		}
+		switch s := v.(type) {
+		case string:
+			node.set(key[len(key)-1], s)
+		case int64:
+			node.set(key[len(key)-1], strconv.FormatInt(s, 10))
+		default:
+			node.set(key[len(key)-1], fmt.Sprint(s))
+		}
+	}
+
+	return root, nil
+}
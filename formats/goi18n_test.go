@@ -0,0 +1,119 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testGoI18nJSON = `{
+  "HelloPerson": "Hello {{.Name}}",
+  "PersonCats": {
+    "description": "Number of cats a person has",
+    "hash": "sha1-abc",
+    "one": "{{.Name}} has {{.Count}} cat.",
+    "other": "{{.Name}} has {{.Count}} cats."
+  },
+  "Farewell": {
+    "description": "Said when leaving",
+    "other": "Bye"
+  },
+  "nested": {
+    "title": "Nested"
+  }
+}
+`
+
+func TestGoI18nJSONCodec(t *testing.T) {
+	c, err := ParseString(GoI18nJSON, testGoI18nJSON)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"HelloPerson", "PersonCats", "Farewell", "nested.title"}, c.Keys())
+
+	m, _ := c.Get("PersonCats")
+	assert.Equal(t, map[string]string{One: "{{.Name}} has {{.Count}} cat.", Other: "{{.Name}} has {{.Count}} cats."}, m.Plural)
+	assert.Equal(t, []string{"Number of cats a person has"}, m.Comments)
+	assert.Equal(t, []string{"hash:sha1-abc"}, m.Flags)
+	m, _ = c.Get("Farewell")
+	assert.Equal(t, "Bye", m.Value)
+	assert.False(t, m.IsPlural())
+
+	var b bytes.Buffer
+	assert.Nil(t, Write(GoI18nJSON, &b, c))
+	assert.Equal(t, `{
+  "HelloPerson": "Hello {{.Name}}",
+  "PersonCats": {
+    "description": "Number of cats a person has",
+    "hash": "sha1-abc",
+    "one": "{{.Name}} has {{.Count}} cat.",
+    "other": "{{.Name}} has {{.Count}} cats."
+  },
+  "Farewell": {
+    "description": "Said when leaving",
+    "other": "Bye"
+  },
+  "nested.title": "Nested"
+}
+`, b.String())
+
+	_, err = ParseString(GoI18nJSON, `{"a": {"one": "x"}}`)
+	assert.NotNil(t, err)
+	b.Reset()
+	assert.Nil(t, Write(GoI18nJSON, &b, NewCatalog("en")))
+	assert.Equal(t, "{}\n", b.String())
+}
+
+func TestGoI18nTOMLCodec(t *testing.T) {
+	c, err := ParseString(GoI18nTOML, `HelloPerson = "Hello {{.Name}}"
+"dotted.key" = "Dotted"
+Count = 5
+
+[PersonCats]
+description = "Number of cats"
+leftdelim = "<<"
+rightdelim = ">>"
+one = "<<.Name>> has <<.Count>> cat."
+other = """<<.Name>> has
+<<.Count>> cats."""
+
+[nested]
+title = "Nested"
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"HelloPerson", "dotted.key", "Count", "PersonCats", "nested.title"}, c.Keys())
+	m, _ := c.Get("PersonCats")
+	assert.Equal(t, "<<.Name>> has\n<<.Count>> cats.", m.Plural[Other])
+	assert.Equal(t, []string{"leftdelim:<<", "rightdelim:>>"}, m.Flags)
+	m, _ = c.Get("Count")
+	assert.Equal(t, "5", m.Value)
+
+	var b bytes.Buffer
+	assert.Nil(t, Write(GoI18nTOML, &b, c))
+	assert.Equal(t, `HelloPerson = "Hello {{.Name}}"
+"dotted.key" = "Dotted"
+Count = "5"
+"nested.title" = "Nested"
+
+[PersonCats]
+description = "Number of cats"
+leftdelim = "<<"
+rightdelim = ">>"
+one = "<<.Name>> has <<.Count>> cat."
+other = "<<.Name>> has\n<<.Count>> cats."
+`, b.String())
+
+	converted, err := ParseString(GoI18nTOML, b.String())
+	assert.Nil(t, err)
+	assert.Equal(t, 5, converted.Len())
+
+	_, err = ParseString(GoI18nTOML, `a = `)
+	assert.NotNil(t, err)
+}
+
+func TestGoI18nConvert(t *testing.T) {
+	var b bytes.Buffer
+	losses, err := Convert(HierarchicalJSON, GoI18nTOML, bytes.NewBufferString(`{"cart": {"items": {"one": "1 item", "other": "{{.Count}} items"}, "title": "Cart \"1\""}}`), &b)
+	assert.Nil(t, err)
+	assert.Nil(t, losses)
+	assert.Equal(t, "\"cart.title\" = \"Cart \\\"1\\\"\"\n\n[\"cart.items\"]\none = \"1 item\"\nother = \"{{.Count}} items\"\n", b.String())
+}
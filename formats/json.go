@@ -0,0 +1,156 @@
+package formats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// JSONCodec is a codec of hierarchical JSON files, nested keys are joined by Separator
+type JSONCodec struct {
+	Separator string
+}
+
+// Decode parses hierarchical JSON file keeping order of keys
+func (j *JSONCodec) Decode(r io.Reader) (*Catalog, error) {
+	t, err := decodeJSON(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewCatalog("")
+	t.flatten(c, nil, j.Separator)
+
+	return c, nil
+}
+
+// decodeJSON parses JSON document with object at top level into tree
+func decodeJSON(r io.Reader) (*tree, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return nil, fmt.Errorf("json: expected object at top level")
+	}
+
+	return decodeJSONObject(dec, "")
+}
+
+// Encode writes catalog as hierarchical JSON file indented by two spaces
+func (j *JSONCodec) Encode(w io.Writer, c *Catalog) error {
+	t, err := unflatten(c, j.Separator)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := encodeJSONTree(bw, t, 0); err != nil {
+		return err
+	}
+	bw.WriteString("\n")
+
+	return bw.Flush()
+}
+
+// decodeJSONObject parses object after its opening brace, path is a path of object used in errors
+func decodeJSONObject(dec *json.Decoder, path string) (*tree, error) {
+	t := newTree()
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := tok.(string)
+
+		value, err := decodeJSONValue(dec, strings.TrimPrefix(path+"."+key, "."))
+		if err != nil {
+			return nil, err
+		}
+		t.set(key, value)
+	}
+
+	// closing brace
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// decodeJSONValue parses string or object, other values can not be written back by Encode, so they are rejected
+func decodeJSONValue(dec *json.Decoder, path string) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := tok.(type) {
+	case json.Delim:
+		if v == '{' {
+			return decodeJSONObject(dec, path)
+		}
+		return nil, fmt.Errorf("json: %s: arrays are not supported", path)
+	case string:
+		return v, nil
+	case nil:
+		return nil, fmt.Errorf("json: %s: null is not supported", path)
+	default:
+		return nil, fmt.Errorf("json: %s: %v is not a string", path, v)
+	}
+}
+
+func encodeJSONTree(w *bufio.Writer, t *tree, depth int) error {
+	if len(t.keys) == 0 {
+		_, err := w.WriteString("{}")
+		return err
+	}
+
+	indent := strings.Repeat("  ", depth+1)
+	w.WriteString("{\n")
+	for i, k := range t.keys {
+		w.WriteString(indent)
+		if err := encodeJSONString(w, k); err != nil {
+			return err
+		}
+		w.WriteString(": ")
+
+		switch v := t.values[k].(type) {
+		case string:
+			if err := encodeJSONString(w, v); err != nil {
+				return err
+			}
+		case *tree:
+			if err := encodeJSONTree(w, v, depth+1); err != nil {
+				return err
+			}
+		}
+
+		if i < len(t.keys)-1 {
+			w.WriteString(",")
+		}
+		w.WriteString("\n")
+	}
+	w.WriteString(strings.Repeat("  ", depth))
+	_, err := w.WriteString("}")
+
+	return err
+}
+
+func encodeJSONString(w *bufio.Writer, s string) error {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+
+	_, err := w.Write(bytes.TrimRight(b.Bytes(), "\n"))
+	return err
+}
@@ -0,0 +1,89 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testJSON = `{
+  "app": {
+    "title": "Hello <b>world</b>",
+    "items": {
+      "one": "{{count}} item",
+      "other": "{{count}} items"
+    },
+    "empty": ""
+  }
+}
+`
+
+func TestJSONDecode(t *testing.T) {
+	c, err := ParseString(HierarchicalJSON, testJSON)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Message{
+		&Message{Key: "app.title", Value: "Hello <b>world</b>"},
+		&Message{Key: "app.items", Plural: map[string]string{One: "{{count}} item", Other: "{{count}} items"}},
+		&Message{Key: "app.empty", Value: ""},
+	}, c.Messages)
+
+	_, err = ParseString(HierarchicalJSON, `["a"]`)
+	assert.NotNil(t, err)
+	_, err = ParseString(HierarchicalJSON, `{"a": `)
+	assert.NotNil(t, err)
+
+	// values which Encode can not write back are rejected instead of changing structure of file
+	for content, msg := range map[string]string{
+		`{"days": ["Mon", "Tue"]}`:   "json: days: arrays are not supported",
+		`{"app": {"count": 3}}`:      "json: app.count: 3 is not a string",
+		`{"app": {"enabled": true}}`: "json: app.enabled: true is not a string",
+		`{"empty": null}`:            "json: empty: null is not supported",
+	} {
+		_, err = ParseString(HierarchicalJSON, content)
+		assert.Equal(t, msg, err.Error())
+	}
+}
+
+func TestJSONEncode(t *testing.T) {
+	c := NewCatalog("en")
+	c.Add(&Message{Key: "app.title", Value: "Hello <b>world</b>"})
+	c.Add(&Message{Key: "app.items", Plural: map[string]string{Other: "{{count}} items", One: "{{count}} item"}})
+	c.Add(&Message{Key: "app.empty", Value: ""})
+	c.Add(&Message{Key: "days.0", Value: "Mon"})
+	c.Add(&Message{Key: "days.1", Value: "Tue"})
+
+	var b bytes.Buffer
+	err := Write(HierarchicalJSON, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, `{
+  "app": {
+    "title": "Hello <b>world</b>",
+    "items": {
+      "one": "{{count}} item",
+      "other": "{{count}} items"
+    },
+    "empty": ""
+  },
+  "days": {
+    "0": "Mon",
+    "1": "Tue"
+  }
+}
+`, b.String())
+
+	c.Add(&Message{Key: "app.title.sub", Value: "conflict"})
+	assert.NotNil(t, Write(HierarchicalJSON, &b, c))
+}
+
+func TestJSONWithCustomSeparator(t *testing.T) {
+	codec := &JSONCodec{Separator: "/"}
+	c, err := codec.Decode(bytes.NewBufferString(`{"a.b": {"c": "d"}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.b/c"}, c.Keys())
+
+	var b bytes.Buffer
+	err = (&JSONCodec{}).Encode(&b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n  \"a.b/c\": \"d\"\n}\n", b.String())
+}
@@ -0,0 +1,359 @@
+package formats
+
+import (
+	"strings"
+)
+
+// pluralRule is a CLDR plural rule of language, categories contain also categories used only by fractions
+type pluralRule struct {
+	categories []string
+	integer    func(n int) string
+	// forms is a gettext Plural-Forms header value equivalent to integer rule
+	forms string
+}
+
+var (
+	ruleOther = pluralRule{
+		[]string{Other},
+		func(n int) string { return Other },
+		"nplurals=1; plural=0;",
+	}
+	ruleOneOther = pluralRule{
+		[]string{One, Other},
+		func(n int) string {
+			if n == 1 {
+				return One
+			}
+			return Other
+		},
+		"nplurals=2; plural=(n != 1);",
+	}
+	ruleZeroOneOther = pluralRule{
+		[]string{One, Other},
+		func(n int) string {
+			if n == 0 || n == 1 {
+				return One
+			}
+			return Other
+		},
+		"nplurals=2; plural=(n > 1);",
+	}
+	ruleFrench = pluralRule{
+		[]string{One, Many, Other},
+		func(n int) string {
+			switch {
+			case n == 0 || n == 1:
+				return One
+			case n%1000000 == 0:
+				return Many
+			}
+			return Other
+		},
+		ruleZeroOneOther.forms,
+	}
+	ruleOneManyOther = pluralRule{
+		[]string{One, Many, Other},
+		func(n int) string {
+			switch {
+			case n == 1:
+				return One
+			case n != 0 && n%1000000 == 0:
+				return Many
+			}
+			return Other
+		},
+		ruleOneOther.forms,
+	}
+	ruleFilipino = pluralRule{
+		[]string{One, Other},
+		func(n int) string {
+			switch {
+			case n >= 1 && n <= 3:
+				return One
+			case n%10 == 4 || n%10 == 6 || n%10 == 9:
+				return Other
+			}
+			return One
+		},
+		"nplurals=2; plural=(n != 1 && n != 2 && n != 3 && (n % 10 == 4 || n % 10 == 6 || n % 10 == 9));",
+	}
+	ruleEastSlavic = pluralRule{
+		[]string{One, Few, Many, Other},
+		func(n int) string {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return One
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return Few
+			}
+			return Many
+		},
+		"nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+	}
+	rulePolish = pluralRule{
+		[]string{One, Few, Many, Other},
+		func(n int) string {
+			switch {
+			case n == 1:
+				return One
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return Few
+			}
+			return Many
+		},
+		"nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+	}
+	ruleCzech = pluralRule{
+		[]string{One, Few, Many, Other},
+		func(n int) string {
+			switch {
+			case n == 1:
+				return One
+			case n >= 2 && n <= 4:
+				return Few
+			}
+			return Other
+		},
+		"nplurals=3; plural=(n==1) ? 0 : (n>=2 && n<=4) ? 1 : 2;",
+	}
+	ruleSerboCroatian = pluralRule{
+		[]string{One, Few, Other},
+		func(n int) string {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return One
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return Few
+			}
+			return Other
+		},
+		"nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+	}
+	ruleSlovenian = pluralRule{
+		[]string{One, Two, Few, Other},
+		func(n int) string {
+			switch n % 100 {
+			case 1:
+				return One
+			case 2:
+				return Two
+			case 3, 4:
+				return Few
+			}
+			return Other
+		},
+		"nplurals=4; plural=(n%100==1 ? 0 : n%100==2 ? 1 : n%100==3 || n%100==4 ? 2 : 3);",
+	}
+	ruleLithuanian = pluralRule{
+		[]string{One, Few, Many, Other},
+		func(n int) string {
+			switch {
+			case n%10 == 1 && (n%100 < 11 || n%100 > 19):
+				return One
+			case n%10 >= 2 && (n%100 < 11 || n%100 > 19):
+				return Few
+			}
+			return Other
+		},
+		"nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && (n%100<10 || n%100>=20) ? 1 : 2);",
+	}
+	ruleLatvian = pluralRule{
+		[]string{Zero, One, Other},
+		func(n int) string {
+			switch {
+			case n%10 == 0 || (n%100 >= 11 && n%100 <= 19):
+				return Zero
+			case n%10 == 1 && n%100 != 11:
+				return One
+			}
+			return Other
+		},
+		"nplurals=3; plural=(n%10==0 || (n%100>=11 && n%100<=19) ? 0 : n%10==1 && n%100!=11 ? 1 : 2);",
+	}
+	ruleRomanian = pluralRule{
+		[]string{One, Few, Other},
+		func(n int) string {
+			switch {
+			case n == 1:
+				return One
+			case n == 0 || (n != 1 && n%100 >= 1 && n%100 <= 19):
+				return Few
+			}
+			return Other
+		},
+		"nplurals=3; plural=(n==1 ? 0 : (n==0 || (n%100 > 0 && n%100 < 20)) ? 1 : 2);",
+	}
+	ruleArabic = pluralRule{
+		[]string{Zero, One, Two, Few, Many, Other},
+		func(n int) string {
+			switch {
+			case n == 0:
+				return Zero
+			case n == 1:
+				return One
+			case n == 2:
+				return Two
+			case n%100 >= 3 && n%100 <= 10:
+				return Few
+			case n%100 >= 11:
+				return Many
+			}
+			return Other
+		},
+		"nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5);",
+	}
+	ruleHebrew = pluralRule{
+		[]string{One, Two, Other},
+		func(n int) string {
+			switch n {
+			case 1:
+				return One
+			case 2:
+				return Two
+			}
+			return Other
+		},
+		"nplurals=3; plural=(n==1 ? 0 : n==2 ? 1 : 2);",
+	}
+	ruleIrish = pluralRule{
+		[]string{One, Two, Few, Many, Other},
+		func(n int) string {
+			switch {
+			case n == 1:
+				return One
+			case n == 2:
+				return Two
+			case n >= 3 && n <= 6:
+				return Few
+			case n >= 7 && n <= 10:
+				return Many
+			}
+			return Other
+		},
+		"nplurals=5; plural=(n==1 ? 0 : n==2 ? 1 : n>=3 && n<=6 ? 2 : n>=7 && n<=10 ? 3 : 4);",
+	}
+	ruleWelsh = pluralRule{
+		[]string{Zero, One, Two, Few, Many, Other},
+		func(n int) string {
+			switch n {
+			case 0:
+				return Zero
+			case 1:
+				return One
+			case 2:
+				return Two
+			case 3:
+				return Few
+			case 6:
+				return Many
+			}
+			return Other
+		},
+		"nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n==3 ? 3 : n==6 ? 4 : 5);",
+	}
+	ruleIcelandic = pluralRule{
+		[]string{One, Other},
+		func(n int) string {
+			if n%10 == 1 && n%100 != 11 {
+				return One
+			}
+			return Other
+		},
+		"nplurals=2; plural=(n%10!=1 || n%100==11);",
+	}
+)
+
+// pluralRules maps language (or language and region) to CLDR plural rule
+var pluralRules = map[string]pluralRule{
+	"ja": ruleOther, "zh": ruleOther, "ko": ruleOther, "th": ruleOther, "vi": ruleOther,
+	"id": ruleOther, "ms": ruleOther, "lo": ruleOther, "my": ruleOther, "km": ruleOther,
+
+	"en": ruleOneOther, "de": ruleOneOther, "nl": ruleOneOther, "sv": ruleOneOther, "da": ruleOneOther,
+	"no": ruleOneOther, "nb": ruleOneOther, "nn": ruleOneOther, "fi": ruleOneOther, "et": ruleOneOther,
+	"el": ruleOneOther, "hu": ruleOneOther, "tr": ruleOneOther, "bg": ruleOneOther, "eu": ruleOneOther,
+	"gl": ruleOneOther, "ka": ruleOneOther, "kk": ruleOneOther, "az": ruleOneOther, "sq": ruleOneOther,
+	"ur": ruleOneOther, "sw": ruleOneOther, "ta": ruleOneOther, "te": ruleOneOther, "ml": ruleOneOther,
+	"mr": ruleOneOther, "ne": ruleOneOther, "af": ruleOneOther, "uz": ruleOneOther, "mn": ruleOneOther,
+
+	"es": ruleOneManyOther, "it": ruleOneManyOther, "ca": ruleOneManyOther, "pt-pt": ruleOneManyOther,
+	"fil": ruleFilipino,
+
+	"fr": ruleFrench, "pt": ruleFrench, "hi": ruleZeroOneOther, "bn": ruleZeroOneOther,
+	"gu": ruleZeroOneOther, "fa": ruleZeroOneOther, "am": ruleZeroOneOther, "zu": ruleZeroOneOther,
+	"kn": ruleZeroOneOther, "hy": ruleZeroOneOther,
+
+	"ru": ruleEastSlavic, "uk": ruleEastSlavic, "be": ruleEastSlavic,
+	"pl": rulePolish,
+	"cs": ruleCzech, "sk": ruleCzech,
+	"hr": ruleSerboCroatian, "sr": ruleSerboCroatian, "bs": ruleSerboCroatian,
+	"sl": ruleSlovenian,
+	"lt": ruleLithuanian,
+	"lv": ruleLatvian,
+	"ro": ruleRomanian, "mo": ruleRomanian,
+	"ar": ruleArabic,
+	"he": ruleHebrew, "iw": ruleHebrew,
+	"ga": ruleIrish,
+	"cy": ruleWelsh,
+	"is": ruleIcelandic, "mk": ruleIcelandic,
+}
+
+// lookupPluralRule returns plural rule of locale, e.g. "pt-PT", "pt_BR" or "pl"
+func lookupPluralRule(locale string) (pluralRule, bool) {
+	locale = strings.ToLower(strings.Replace(locale, "_", "-", -1))
+	if r, ok := pluralRules[locale]; ok {
+		return r, true
+	}
+
+	if i := strings.Index(locale, "-"); i > 0 {
+		r, ok := pluralRules[locale[:i]]
+		return r, ok
+	}
+
+	return pluralRule{}, false
+}
+
+// PluralCategoriesFor returns CLDR plural categories used by locale, ok is false for unknown locale
+func PluralCategoriesFor(locale string) ([]string, bool) {
+	r, ok := lookupPluralRule(locale)
+	if !ok {
+		return nil, false
+	}
+
+	return append([]string{}, r.categories...), true
+}
+
+// RequiredPluralCategoriesFor returns CLDR plural categories of locale which integer counts up to 1000 reach, in
+// canonical order. Categories used only by fractions or by large round numbers, e.g. many of million in French,
+// are optional because gettext and Android files usually can not express them. ok is false for unknown locale.
+func RequiredPluralCategoriesFor(locale string) ([]string, bool) {
+	r, ok := lookupPluralRule(locale)
+	if !ok {
+		return nil, false
+	}
+
+	reached := map[string]bool{}
+	for n := 0; n <= 1000; n++ {
+		reached[r.integer(n)] = true
+	}
+	var categories []string
+	for _, c := range PluralCategories {
+		if reached[c] {
+			categories = append(categories, c)
+		}
+	}
+
+	return categories, true
+}
+
+// PluralCategory returns CLDR plural category of integer count in locale, "other" is returned for unknown locale
+func PluralCategory(locale string, n int) string {
+	if n < 0 {
+		n = -n
+	}
+	r, ok := lookupPluralRule(locale)
+	if !ok {
+		return Other
+	}
+
+	return r.integer(n)
+}
@@ -0,0 +1,310 @@
+package formats
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pluralForms is a parsed gettext Plural-Forms header, categories maps msgstr index to CLDR category
+type pluralForms struct {
+	n          int
+	plural     func(n int) int
+	categories []string
+}
+
+// pluralFormsFor returns plural forms of catalog based on Plural-Forms header and locale
+func pluralFormsFor(header, locale string, fallback int) pluralForms {
+	if pf, err := parsePluralForms(header); err == nil {
+		if categories, ok := mapPluralForms(pf, locale); ok {
+			pf.categories = categories
+			return pf
+		}
+		return pluralForms{n: pf.n, plural: pf.plural, categories: defaultPluralCategories(pf.n)}
+	}
+
+	if r, ok := lookupPluralRule(locale); ok {
+		if pf, err := parsePluralForms(r.forms); err == nil {
+			pf.categories, _ = mapPluralForms(pf, locale)
+			return pf
+		}
+	}
+
+	return pluralForms{n: fallback, categories: defaultPluralCategories(fallback)}
+}
+
+// mapPluralForms assigns CLDR category to each plural form index by evaluating both rules for sample numbers
+func mapPluralForms(pf pluralForms, locale string) ([]string, bool) {
+	if _, ok := lookupPluralRule(locale); !ok {
+		return nil, false
+	}
+
+	counts := make([]map[string]int, pf.n)
+	for i := range counts {
+		counts[i] = map[string]int{}
+	}
+	for n := 0; n <= 1000; n++ {
+		i := pf.plural(n)
+		if i < 0 || i >= pf.n {
+			return nil, false
+		}
+		counts[i][PluralCategory(locale, n)]++
+	}
+
+	categories := make([]string, pf.n)
+	used := map[string]bool{}
+	for i, c := range counts {
+		best := ""
+		for _, category := range PluralCategories {
+			if c[category] > c[best] {
+				best = category
+			}
+		}
+		if best == "" || used[best] {
+			return nil, false
+		}
+		used[best] = true
+		categories[i] = best
+	}
+
+	return categories, true
+}
+
+// defaultPluralCategories returns categories for number of plural forms of unknown language
+func defaultPluralCategories(n int) []string {
+	switch n {
+	case 1:
+		return []string{Other}
+	case 2:
+		return []string{One, Other}
+	case 3:
+		return []string{One, Few, Other}
+	case 4:
+		return []string{One, Two, Few, Other}
+	case 5:
+		return []string{One, Two, Few, Many, Other}
+	case 6:
+		return []string{Zero, One, Two, Few, Many, Other}
+	}
+
+	return nil
+}
+
+// parsePluralForms parses "nplurals=N; plural=EXPRESSION;" header value
+func parsePluralForms(header string) (pluralForms, error) {
+	pf := pluralForms{}
+	var expr string
+	for _, part := range strings.Split(header, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.TrimSpace(kv[0]) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+			if err != nil || n < 1 {
+				return pf, fmt.Errorf("invalid nplurals: %s", kv[1])
+			}
+			pf.n = n
+		case "plural":
+			expr = kv[1]
+		}
+	}
+	if pf.n == 0 || expr == "" {
+		return pf, fmt.Errorf("invalid Plural-Forms: %s", header)
+	}
+
+	p := &exprParser{src: expr}
+	f, err := p.ternary()
+	if err != nil {
+		return pf, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return pf, fmt.Errorf("unexpected %q in plural expression", p.src[p.pos:])
+	}
+	pf.plural = f
+
+	return pf, nil
+}
+
+type exprFunc func(n int) int
+
+// exprParser is a recursive descent parser of C expressions used in Plural-Forms
+type exprParser struct {
+	src string
+	pos int
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.src) && strings.ContainsRune(" \t\n\r", rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *exprParser) accept(op string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.pos:], op) {
+		p.pos += len(op)
+		return true
+	}
+
+	return false
+}
+
+func (p *exprParser) ternary() (exprFunc, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return cond, nil
+	}
+
+	yes, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept(":") {
+		return nil, fmt.Errorf("expected : in plural expression")
+	}
+	no, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n int) int {
+		if cond(n) != 0 {
+			return yes(n)
+		}
+		return no(n)
+	}, nil
+}
+
+// binaryLevels are binary operators by precedence, from the lowest
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) binary(level int) (exprFunc, error) {
+	if level == len(binaryLevels) {
+		return p.unary()
+	}
+
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := ""
+		for _, candidate := range binaryLevels[level] {
+			if p.accept(candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryOp(op, left, right)
+	}
+}
+
+func binaryOp(op string, l, r exprFunc) exprFunc {
+	b := func(v bool) int {
+		if v {
+			return 1
+		}
+		return 0
+	}
+
+	switch op {
+	case "||":
+		return func(n int) int { return b(l(n) != 0 || r(n) != 0) }
+	case "&&":
+		return func(n int) int { return b(l(n) != 0 && r(n) != 0) }
+	case "==":
+		return func(n int) int { return b(l(n) == r(n)) }
+	case "!=":
+		return func(n int) int { return b(l(n) != r(n)) }
+	case "<=":
+		return func(n int) int { return b(l(n) <= r(n)) }
+	case ">=":
+		return func(n int) int { return b(l(n) >= r(n)) }
+	case "<":
+		return func(n int) int { return b(l(n) < r(n)) }
+	case ">":
+		return func(n int) int { return b(l(n) > r(n)) }
+	case "+":
+		return func(n int) int { return l(n) + r(n) }
+	case "-":
+		return func(n int) int { return l(n) - r(n) }
+	case "*":
+		return func(n int) int { return l(n) * r(n) }
+	case "/":
+		return func(n int) int {
+			if d := r(n); d != 0 {
+				return l(n) / d
+			}
+			return 0
+		}
+	default:
+		return func(n int) int {
+			if d := r(n); d != 0 {
+				return l(n) % d
+			}
+			return 0
+		}
+	}
+}
+
+func (p *exprParser) unary() (exprFunc, error) {
+	if p.accept("!") {
+		f, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int) int {
+			if f(n) == 0 {
+				return 1
+			}
+			return 0
+		}, nil
+	}
+
+	if p.accept("(") {
+		f, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("expected ) in plural expression")
+		}
+		return f, nil
+	}
+
+	if p.accept("n") {
+		return func(n int) int { return n }, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, fmt.Errorf("unexpected %q in plural expression", p.src[p.pos:])
+	}
+	v, _ := strconv.Atoi(p.src[start:p.pos])
+
+	return func(int) int { return v }, nil
+}
@@ -0,0 +1,409 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// GNU gettext formats
+const (
+	GNUPO  Format = "GNU_PO"
+	GNUPOT Format = "GNU_POT"
+)
+
+// POCodec is a codec of GNU gettext PO and POT files
+type POCodec struct {
+	// Template makes Encode write empty translations
+	Template bool
+}
+
+// poEntry is a single entry of PO file before plural forms are mapped to CLDR categories
+type poEntry struct {
+	msg     *Message
+	msgstr  map[int]string
+	plurals int
+	field   string
+	index   int
+	started bool
+}
+
+// Decode parses PO file, msgstr[n] are mapped to CLDR categories using Plural-Forms header and Language
+func (p *POCodec) Decode(r io.Reader) (*Catalog, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	c := NewCatalog("")
+	var entries []*poEntry
+	entry := newPOEntry()
+	flush := func() {
+		if entry.started {
+			entries = append(entries, entry)
+		}
+		entry = newPOEntry()
+	}
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		obsolete := false
+		if strings.HasPrefix(line, "#~") {
+			obsolete = true
+			line = strings.TrimSpace(line[2:])
+			if strings.HasPrefix(line, "|") {
+				line = "#" + line
+			}
+		}
+
+		switch {
+		case line == "":
+			flush()
+			continue
+		case strings.HasPrefix(line, "#"):
+			if entry.field != "" {
+				flush()
+			}
+			entry.comment(line)
+			continue
+		}
+
+		keyword, rest := splitPOKeyword(line)
+		if keyword == "" {
+			// continuation of previous string
+			s, err := unquotePO(line)
+			if err != nil || entry.field == "" {
+				return nil, fmt.Errorf("po: line %d: unexpected %s", lineNo, line)
+			}
+			entry.appendString(s)
+			continue
+		}
+
+		s, err := unquotePO(rest)
+		if err != nil {
+			return nil, fmt.Errorf("po: line %d: %s", lineNo, err)
+		}
+		if (keyword == "msgctxt" || keyword == "msgid") && (entry.field == "msgstr" || entry.field == "msgid_plural" && keyword == "msgid") {
+			flush()
+		}
+		if err := entry.set(keyword, s); err != nil {
+			return nil, fmt.Errorf("po: line %d: %s", lineNo, err)
+		}
+		entry.msg.Obsolete = entry.msg.Obsolete || obsolete
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+
+	// header is the first entry with empty msgid
+	if len(entries) > 0 && entries[0].msg.Key == "" && entries[0].msg.Context == "" {
+		h := entries[0]
+		c.HeaderComments = h.msg.Comments
+		c.HeaderFlags = h.msg.Flags
+		for _, line := range strings.Split(h.msgstr[-1], "\n") {
+			kv := strings.SplitN(line, ":", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			c.Header = append(c.Header, HeaderField{Name: strings.TrimSpace(kv[0]), Value: strings.TrimSpace(kv[1])})
+		}
+		entries = entries[1:]
+	}
+	c.Locale = c.HeaderValue("Language")
+
+	maxPlurals := 0
+	for _, e := range entries {
+		if e.plurals > maxPlurals {
+			maxPlurals = e.plurals
+		}
+	}
+	forms := pluralFormsFor(c.HeaderValue("Plural-Forms"), c.Locale, maxPlurals)
+
+	for _, e := range entries {
+		if e.msg.PluralKey == "" {
+			e.msg.Value = e.msgstr[-1]
+			c.Add(e.msg)
+			continue
+		}
+
+		e.msg.Plural = map[string]string{}
+		for i, s := range e.msgstr {
+			if i < 0 || i >= len(forms.categories) {
+				return nil, fmt.Errorf("po: message %s has msgstr[%d] not covered by Plural-Forms", e.msg.Key, i)
+			}
+			e.msg.Plural[forms.categories[i]] = s
+		}
+		c.Add(e.msg)
+	}
+
+	return c, nil
+}
+
+// Encode writes catalog as PO file, Plural-Forms header is added for plural messages when it is missing
+func (p *POCodec) Encode(w io.Writer, c *Catalog) error {
+	bw := bufio.NewWriter(w)
+
+	header := append([]HeaderField{}, c.Header...)
+	hasPlurals := false
+	for _, m := range c.Messages {
+		hasPlurals = hasPlurals || m.IsPlural()
+	}
+	h := &Catalog{Header: header}
+	if c.Locale != "" && h.HeaderValue("Language") == "" && !p.Template {
+		h.SetHeader("Language", c.Locale)
+	}
+	if hasPlurals && h.HeaderValue("Plural-Forms") == "" {
+		if r, ok := lookupPluralRule(c.Locale); ok && !p.Template {
+			h.SetHeader("Plural-Forms", r.forms)
+		} else {
+			h.SetHeader("Plural-Forms", "nplurals=2; plural=(n != 1);")
+		}
+	}
+	forms := pluralFormsFor(h.HeaderValue("Plural-Forms"), c.Locale, 2)
+
+	if len(h.Header) > 0 || len(c.HeaderComments) > 0 || len(c.HeaderFlags) > 0 {
+		writePOEntry(bw, &Message{Comments: c.HeaderComments, Flags: c.HeaderFlags}, "", nil)
+		for _, f := range h.Header {
+			fmt.Fprintf(bw, "%s\n", quotePO(f.Name+": "+f.Value+"\n"))
+		}
+		bw.WriteString("\n")
+	}
+
+	for i, m := range c.Messages {
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+
+		if !m.IsPlural() {
+			value := m.Value
+			if p.Template {
+				value = ""
+			}
+			writePOEntry(bw, m, m.PluralKey, []string{value})
+			continue
+		}
+
+		values := make([]string, len(forms.categories))
+		for i, category := range forms.categories {
+			if !p.Template {
+				values[i] = m.Plural[category]
+			}
+		}
+		pluralKey := m.PluralKey
+		if pluralKey == "" {
+			pluralKey = m.Key
+		}
+		writePOEntry(bw, m, pluralKey, values)
+	}
+
+	return bw.Flush()
+}
+
+func newPOEntry() *poEntry {
+	return &poEntry{msg: &Message{}, msgstr: map[int]string{}}
+}
+
+func (e *poEntry) comment(line string) {
+	e.started = true
+	kind, text := "", strings.TrimPrefix(line, "#")
+	if text != "" && strings.ContainsRune(".:,|", rune(text[0])) {
+		kind, text = text[:1], text[1:]
+	}
+	text = strings.TrimSpace(text)
+
+	switch kind {
+	case ".":
+		e.msg.ExtractedComments = append(e.msg.ExtractedComments, text)
+	case ":":
+		e.msg.References = append(e.msg.References, strings.Fields(text)...)
+	case ",":
+		for _, flag := range strings.Split(text, ",") {
+			if flag = strings.TrimSpace(flag); flag != "" {
+				e.msg.Flags = append(e.msg.Flags, flag)
+			}
+		}
+	case "|":
+		e.msg.Previous = append(e.msg.Previous, text)
+	default:
+		e.msg.Comments = append(e.msg.Comments, text)
+	}
+}
+
+func (e *poEntry) set(keyword, s string) error {
+	e.started = true
+	e.field, e.index = keyword, -1
+	switch keyword {
+	case "msgctxt":
+		e.msg.Context = s
+	case "msgid":
+		e.msg.Key = s
+	case "msgid_plural":
+		e.msg.PluralKey = s
+	case "msgstr":
+		e.msgstr[-1] = s
+	default:
+		if !strings.HasPrefix(keyword, "msgstr[") || !strings.HasSuffix(keyword, "]") {
+			return fmt.Errorf("unknown keyword %s", keyword)
+		}
+		i, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+		if err != nil || i < 0 {
+			return fmt.Errorf("invalid keyword %s", keyword)
+		}
+		e.field, e.index = "msgstr", i
+		e.msgstr[i] = s
+		if i+1 > e.plurals {
+			e.plurals = i + 1
+		}
+	}
+
+	return nil
+}
+
+func (e *poEntry) appendString(s string) {
+	switch e.field {
+	case "msgctxt":
+		e.msg.Context += s
+	case "msgid":
+		e.msg.Key += s
+	case "msgid_plural":
+		e.msg.PluralKey += s
+	case "msgstr":
+		e.msgstr[e.index] += s
+	}
+}
+
+func splitPOKeyword(line string) (string, string) {
+	if strings.HasPrefix(line, "\"") {
+		return "", line
+	}
+	i := strings.IndexAny(line, " \t")
+	if i < 0 {
+		return line, ""
+	}
+
+	return line[:i], strings.TrimSpace(line[i:])
+}
+
+func unquotePO(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+
+	var b strings.Builder
+	s = s[1 : len(s)-1]
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'v':
+			b.WriteByte('\v')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String(), nil
+}
+
+func quotePO(s string) string {
+	r := strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "\t", "\\t", "\r", "\\r")
+	return "\"" + r.Replace(s) + "\""
+}
+
+// writePOString writes keyword and string, multi-line strings are split after each newline like gettext does
+func writePOString(w *bufio.Writer, prefix, keyword, s string) {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) <= 1 {
+		fmt.Fprintf(w, "%s%s %s\n", prefix, keyword, quotePO(s))
+		return
+	}
+
+	fmt.Fprintf(w, "%s%s \"\"\n", prefix, keyword)
+	for _, line := range lines {
+		fmt.Fprintf(w, "%s%s\n", prefix, quotePO(line))
+	}
+}
+
+func writePOEntry(w *bufio.Writer, m *Message, pluralKey string, values []string) {
+	for _, c := range m.Comments {
+		writePOComment(w, "# ", c)
+	}
+	for _, c := range m.ExtractedComments {
+		writePOComment(w, "#. ", c)
+	}
+	if len(m.References) > 0 {
+		line := ""
+		for _, ref := range m.References {
+			if line != "" && len(line)+len(ref)+1 > 76 {
+				fmt.Fprintf(w, "#: %s\n", line)
+				line = ""
+			}
+			if line != "" {
+				line += " "
+			}
+			line += ref
+		}
+		fmt.Fprintf(w, "#: %s\n", line)
+	}
+	if len(m.Flags) > 0 {
+		fmt.Fprintf(w, "#, %s\n", strings.Join(m.Flags, ", "))
+	}
+
+	prefix := ""
+	if m.Obsolete {
+		prefix = "#~ "
+	}
+	for _, previous := range m.Previous {
+		if m.Obsolete {
+			fmt.Fprintf(w, "#~| %s\n", previous)
+			continue
+		}
+		fmt.Fprintf(w, "#| %s\n", previous)
+	}
+	if m.Context != "" {
+		writePOString(w, prefix, "msgctxt", m.Context)
+	}
+	writePOString(w, prefix, "msgid", m.Key)
+	if values == nil {
+		// header entry, caller writes header lines
+		fmt.Fprintf(w, "%smsgstr \"\"\n", prefix)
+		return
+	}
+	if pluralKey == "" {
+		writePOString(w, prefix, "msgstr", values[0])
+		return
+	}
+
+	writePOString(w, prefix, "msgid_plural", pluralKey)
+	for i, v := range values {
+		writePOString(w, prefix, fmt.Sprintf("msgstr[%d]", i), v)
+	}
+}
+
+func writePOComment(w *bufio.Writer, prefix, comment string) {
+	if comment == "" {
+		fmt.Fprintln(w, strings.TrimSpace(prefix))
+		return
+	}
+
+	fmt.Fprintf(w, "%s%s\n", prefix, comment)
+}
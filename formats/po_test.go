@@ -0,0 +1,244 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPO = `# Polish translations for app.
+# Copyright (C) 2015
+#, fuzzy
+msgid ""
+msgstr ""
+"Project-Id-Version: app 1.0\n"
+"Language: pl\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Plural-Forms: nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+# Translator note
+#. Shown on home page
+#: src/home.go:10 src/home.go:20
+#, c-format
+msgid "Hello %s"
+msgstr "Witaj %s"
+
+msgctxt "menu"
+msgid "Open"
+msgstr "Otwórz"
+
+#: src/files.go:5
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d plik"
+msgstr[1] "%d pliki"
+msgstr[2] "%d plików"
+
+#, fuzzy
+#| msgid "Old text"
+msgid ""
+"Multi\n"
+"line"
+msgstr ""
+"Wiele\n"
+"linii"
+
+#~ msgid "Removed"
+#~ msgstr "Usunięte"
+`
+
+func TestPODecode(t *testing.T) {
+	c, err := ParseString(GNUPO, testPO)
+	assert.Nil(t, err)
+	assert.Equal(t, "pl", c.Locale)
+	assert.Equal(t, []string{"Polish translations for app.", "Copyright (C) 2015"}, c.HeaderComments)
+	assert.Equal(t, []string{"fuzzy"}, c.HeaderFlags)
+	assert.Equal(t, "app 1.0", c.HeaderValue("Project-Id-Version"))
+	assert.Len(t, c.Header, 4)
+
+	assert.Equal(t, []*Message{
+		&Message{
+			Key:               "Hello %s",
+			Value:             "Witaj %s",
+			Comments:          []string{"Translator note"},
+			ExtractedComments: []string{"Shown on home page"},
+			References:        []string{"src/home.go:10", "src/home.go:20"},
+			Flags:             []string{"c-format"},
+		},
+		&Message{Key: "Open", Context: "menu", Value: "Otwórz"},
+		&Message{
+			Key:        "%d file",
+			PluralKey:  "%d files",
+			Plural:     map[string]string{One: "%d plik", Few: "%d pliki", Many: "%d plików"},
+			References: []string{"src/files.go:5"},
+		},
+		&Message{Key: "Multi\nline", Value: "Wiele\nlinii", Flags: []string{"fuzzy"}, Previous: []string{`msgid "Old text"`}},
+		&Message{Key: "Removed", Value: "Usunięte", Obsolete: true},
+	}, c.Messages)
+	assert.True(t, c.Messages[3].IsFuzzy())
+}
+
+func TestPORoundTrip(t *testing.T) {
+	c, err := ParseString(GNUPO, testPO)
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	err = Write(GNUPO, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, testPO, b.String())
+}
+
+func TestPOEncodeTemplate(t *testing.T) {
+	c := NewCatalog("de")
+	c.Add(&Message{Key: "Hello", Value: "Hallo"})
+	c.Add(&Message{Key: "%d file", PluralKey: "%d files", Plural: map[string]string{One: "%d Datei", Other: "%d Dateien"}})
+
+	var b bytes.Buffer
+	err := Write(GNUPOT, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, `msgid ""
+msgstr ""
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+msgid "Hello"
+msgstr ""
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] ""
+msgstr[1] ""
+`, b.String())
+
+	back, err := ParseString(GNUPOT, b.String())
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{One: "", Other: ""}, back.Messages[1].Plural)
+}
+
+func TestPOEncodeFromOtherFormat(t *testing.T) {
+	c, err := ParseString(HierarchicalJSON, `{"items": {"one": "{{count}} предмет", "few": "{{count}} предмета", "many": "{{count}} предметов", "other": "{{count}} предмета"}}`)
+	assert.Nil(t, err)
+	c.Locale = "ru"
+
+	var b bytes.Buffer
+	err = Write(GNUPO, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, `msgid ""
+msgstr ""
+"Language: ru\n"
+"Plural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+msgid "items"
+msgid_plural "items"
+msgstr[0] "{{count}} предмет"
+msgstr[1] "{{count}} предмета"
+msgstr[2] "{{count}} предметов"
+`, b.String())
+}
+
+func TestPODecodeWithFailure(t *testing.T) {
+	for _, invalid := range []string{
+		"msgid \"a\nmsgstr \"b\"",
+		"\"orphan\"",
+		"msgfoo \"a\"",
+		"msgid \"a\"\nmsgstr[x] \"b\"",
+		"msgid \"\"\nmsgstr \"Plural-Forms: nplurals=2; plural=(n != 1);\\n\"\n\nmsgid \"a\"\nmsgid_plural \"b\"\nmsgstr[5] \"c\"",
+	} {
+		_, err := ParseString(GNUPO, invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestPluralForms(t *testing.T) {
+	pf, err := parsePluralForms("nplurals=3; plural=(n==1) ? 0 : (n>=2 && n<=4) ? 1 : 2;")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, pf.n)
+	assert.Equal(t, []int{2, 0, 1, 1, 1, 2}, []int{pf.plural(0), pf.plural(1), pf.plural(2), pf.plural(3), pf.plural(4), pf.plural(5)})
+
+	categories, ok := mapPluralForms(pf, "cs")
+	assert.True(t, ok)
+	assert.Equal(t, []string{One, Few, Other}, categories)
+
+	pf, err = parsePluralForms("nplurals=2; plural=!(n == 1) && 1 + 2 * 3 - 6 / 2 % 4 > 0;")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, pf.plural(1))
+	assert.Equal(t, 1, pf.plural(5))
+
+	for _, invalid := range []string{"", "nplurals=x; plural=0;", "nplurals=2;", "nplurals=2; plural=(n;", "nplurals=2; plural=n ? 1;", "nplurals=2; plural=n $ 1;"} {
+		_, err := parsePluralForms(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+
+	for language, rule := range pluralRules {
+		pf, err := parsePluralForms(rule.forms)
+		assert.Nil(t, err, language)
+		_, ok := mapPluralForms(pf, language)
+		assert.True(t, ok, language)
+	}
+}
+
+func TestPluralCategory(t *testing.T) {
+	assert.Equal(t, One, PluralCategory("pl", 1))
+	assert.Equal(t, Few, PluralCategory("pl_PL", 22))
+	assert.Equal(t, Many, PluralCategory("pl-PL", 12))
+	assert.Equal(t, One, PluralCategory("pt-BR", 0))
+	assert.Equal(t, Other, PluralCategory("pt-PT", 0))
+	assert.Equal(t, Other, PluralCategory("xx", 1))
+
+	for _, c := range []struct {
+		locale   string
+		n        int
+		category string
+	}{
+		{"ro", 1, One},
+		{"ro", 0, Few},
+		{"ro", 19, Few},
+		{"ro", 20, Other},
+		{"ro", 101, Few},
+		{"ro", 102, Few},
+		{"ro", 120, Other},
+		{"fr", 0, One},
+		{"fr", 2, Other},
+		{"fr", 1000, Other},
+		{"fr", 1000000, Many},
+		{"fr", 2000000, Many},
+		{"pt", 1000000, Many},
+		{"es", 0, Other},
+		{"es", 1, One},
+		{"es", 1000000, Many},
+		{"es", 1000001, Other},
+		{"it", 3000000, Many},
+		{"ca", 1000000, Many},
+		{"pt-PT", 1, One},
+		{"pt-PT", 1000000, Many},
+		{"hy", 0, One},
+		{"hy", 1, One},
+		{"hy", 2, Other},
+		{"kn", 0, One},
+		{"kn", 2, Other},
+		{"fil", 0, One},
+		{"fil", 3, One},
+		{"fil", 4, Other},
+		{"fil", 5, One},
+		{"fil", 6, Other},
+		{"fil", 9, Other},
+		{"fil", 11, One},
+		{"fil", 26, Other},
+	} {
+		assert.Equal(t, c.category, PluralCategory(c.locale, c.n), "%s %d", c.locale, c.n)
+	}
+
+	categories, ok := PluralCategoriesFor("ar")
+	assert.True(t, ok)
+	assert.Equal(t, PluralCategories, categories)
+	_, ok = PluralCategoriesFor("xx")
+	assert.False(t, ok)
+
+	categories, ok = RequiredPluralCategoriesFor("pl")
+	assert.True(t, ok)
+	assert.Equal(t, []string{One, Few, Many}, categories)
+	categories, _ = RequiredPluralCategoriesFor("fr")
+	assert.Equal(t, []string{One, Other}, categories)
+	_, ok = RequiredPluralCategoriesFor("xx")
+	assert.False(t, ok)
+}
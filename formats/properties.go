@@ -0,0 +1,179 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// PropertiesCodec is a codec of Java .properties files
+type PropertiesCodec struct{}
+
+// Decode parses .properties file, comment lines preceding a key are attached to its message
+func (p *PropertiesCodec) Decode(r io.Reader) (*Catalog, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewCatalog("")
+	var comments []string
+	lines := strings.Split(strings.Replace(string(data), "\r\n", "\n", -1), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" {
+			comments = nil
+			continue
+		}
+		if line[0] == '#' || line[0] == '!' {
+			comments = append(comments, strings.TrimSpace(line[1:]))
+			continue
+		}
+
+		// join continuation lines, odd number of trailing backslashes continues logical line
+		for endsWithContinuation(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+		if endsWithContinuation(line) {
+			line = line[:len(line)-1]
+		}
+
+		key, value := splitProperty(line)
+		c.Add(&Message{Key: unescapeProperty(key), Value: unescapeProperty(value), Comments: comments})
+		comments = nil
+	}
+
+	return c, nil
+}
+
+// Encode writes catalog as .properties file, non ASCII characters are written as \uXXXX escapes
+func (p *PropertiesCodec) Encode(w io.Writer, c *Catalog) error {
+	bw := bufio.NewWriter(w)
+	for _, m := range c.Messages {
+		if m.IsPlural() {
+			return fmt.Errorf("properties: plural message %s is not supported", m.Key)
+		}
+		for _, comment := range m.Comments {
+			fmt.Fprintf(bw, "# %s\n", comment)
+		}
+		fmt.Fprintf(bw, "%s=%s\n", escapeProperty(m.Key, true), escapeProperty(m.Value, false))
+	}
+
+	return bw.Flush()
+}
+
+func endsWithContinuation(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+
+	return n%2 == 1
+}
+
+// splitProperty splits logical line to key and value on first unescaped '=', ':' or whitespace
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return line[:i], strings.TrimLeft(line[i+1:], " \t\f")
+		case ' ', '\t', '\f':
+			rest := strings.TrimLeft(line[i:], " \t\f")
+			if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+				rest = strings.TrimLeft(rest[1:], " \t\f")
+			}
+			return line[:i], rest
+		}
+	}
+
+	return line, ""
+}
+
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+4 < len(s) {
+				if r, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					i += 4
+					// surrogate pair is written as two escapes
+					if utf16.IsSurrogate(rune(r)) && i+6 < len(s) && s[i+1:i+3] == "\\u" {
+						if low, err := strconv.ParseUint(s[i+3:i+7], 16, 32); err == nil {
+							if dec := utf16.DecodeRune(rune(r), rune(low)); dec != utf8.RuneError {
+								b.WriteRune(dec)
+								i += 6
+								continue
+							}
+						}
+					}
+					b.WriteRune(rune(r))
+					continue
+				}
+			}
+			b.WriteByte('u')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
+
+func escapeProperty(s string, key bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '\\':
+			b.WriteString("\\\\")
+		case r == '\n':
+			b.WriteString("\\n")
+		case r == '\r':
+			b.WriteString("\\r")
+		case r == '\t':
+			b.WriteString("\\t")
+		case r == '\f':
+			b.WriteString("\\f")
+		case r == '=' || r == ':' || r == '#' || r == '!':
+			if key || i == 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		case r == ' ' && (key || i == 0):
+			b.WriteString("\\ ")
+		case r > 0x7e || r < 0x20:
+			for _, u := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&b, "\\u%04x", u)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
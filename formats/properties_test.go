@@ -0,0 +1,51 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertiesDecode(t *testing.T) {
+	c, err := ParseString(JavaProperties, `# Greeting
+! shown on home page
+greeting = Hello, {0}!
+colon:value
+space value with spaces
+multi = first \
+        second
+escaped\ key = tab\there\nnew line
+unicode=\u017c\u00f3\u0142w \ud83d\ude00
+
+empty
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Message{
+		&Message{Key: "greeting", Value: "Hello, {0}!", Comments: []string{"Greeting", "shown on home page"}},
+		&Message{Key: "colon", Value: "value"},
+		&Message{Key: "space", Value: "value with spaces"},
+		&Message{Key: "multi", Value: "first second"},
+		&Message{Key: "escaped key", Value: "tab\there\nnew line"},
+		&Message{Key: "unicode", Value: "żółw 😀"},
+		&Message{Key: "empty", Value: ""},
+	}, c.Messages)
+}
+
+func TestPropertiesEncode(t *testing.T) {
+	c := NewCatalog("pl")
+	c.Add(&Message{Key: "greeting", Value: "Hello, {0}!", Comments: []string{"Greeting"}})
+	c.Add(&Message{Key: "escaped key=", Value: " żółw 😀\n#"})
+
+	var b bytes.Buffer
+	err := Write(JavaProperties, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, "# Greeting\ngreeting=Hello, {0}!\nescaped\\ key\\==\\ \\u017c\\u00f3\\u0142w \\ud83d\\ude00\\n#\n", b.String())
+
+	back, err := ParseString(JavaProperties, b.String())
+	assert.Nil(t, err)
+	assert.Equal(t, c.Messages, back.Messages)
+
+	c.Add(&Message{Key: "items", Plural: map[string]string{Other: "items"}})
+	assert.NotNil(t, Write(JavaProperties, &b, c))
+}
@@ -0,0 +1,262 @@
+package formats
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// StringsCodec is a codec of iOS .strings files
+type StringsCodec struct{}
+
+// Decode parses .strings file encoded in UTF-8 or UTF-16 with BOM, comments preceding a key are attached to its message
+func (s *StringsCodec) Decode(r io.Reader) (*Catalog, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &stringsParser{src: []rune(decodeBOM(data)), line: 1}
+	c := NewCatalog("")
+	for {
+		comments, err := p.skipSpaceAndComments()
+		if err != nil {
+			return nil, err
+		}
+		if p.eof() {
+			return c, nil
+		}
+
+		key, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+		value, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(';'); err != nil {
+			return nil, err
+		}
+
+		c.Add(&Message{Key: key, Value: value, Comments: comments})
+	}
+}
+
+// Encode writes catalog as UTF-8 .strings file
+func (s *StringsCodec) Encode(w io.Writer, c *Catalog) error {
+	bw := bufio.NewWriter(w)
+	for i, m := range c.Messages {
+		if m.IsPlural() {
+			return fmt.Errorf("strings: plural message %s is not supported, use stringsdict", m.Key)
+		}
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		for _, comment := range m.Comments {
+			fmt.Fprintf(bw, "/* %s */\n", strings.Replace(comment, "*/", "* /", -1))
+		}
+		fmt.Fprintf(bw, "%s = %s;\n", quoteStrings(m.Key), quoteStrings(m.Value))
+	}
+
+	return bw.Flush()
+}
+
+type stringsParser struct {
+	src  []rune
+	pos  int
+	line int
+}
+
+func (p *stringsParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *stringsParser) next() rune {
+	r := p.src[p.pos]
+	p.pos++
+	if r == '\n' {
+		p.line++
+	}
+
+	return r
+}
+
+func (p *stringsParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("strings: line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+// skipSpaceAndComments skips whitespace and returns comments found on the way
+func (p *stringsParser) skipSpaceAndComments() ([]string, error) {
+	var comments []string
+	for !p.eof() {
+		r := p.src[p.pos]
+		switch {
+		case unicode.IsSpace(r):
+			p.next()
+		case r == '/' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '*':
+			p.pos += 2
+			start := p.pos
+			for !p.eof() && !(p.src[p.pos] == '*' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '/') {
+				p.next()
+			}
+			if p.eof() {
+				return nil, p.errorf("unterminated comment")
+			}
+			comments = append(comments, strings.TrimSpace(string(p.src[start:p.pos])))
+			p.pos += 2
+		case r == '/' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '/':
+			p.pos += 2
+			start := p.pos
+			for !p.eof() && p.src[p.pos] != '\n' {
+				p.next()
+			}
+			comments = append(comments, strings.TrimSpace(string(p.src[start:p.pos])))
+		default:
+			return comments, nil
+		}
+	}
+
+	return comments, nil
+}
+
+func (p *stringsParser) expect(r rune) error {
+	if _, err := p.skipSpaceAndComments(); err != nil {
+		return err
+	}
+	if p.eof() || p.src[p.pos] != r {
+		return p.errorf("expected %q", r)
+	}
+	p.next()
+
+	return nil
+}
+
+// token reads quoted string or unquoted identifier
+func (p *stringsParser) token() (string, error) {
+	if _, err := p.skipSpaceAndComments(); err != nil {
+		return "", err
+	}
+	if p.eof() {
+		return "", p.errorf("unexpected end of file")
+	}
+
+	if p.src[p.pos] != '"' {
+		start := p.pos
+		for !p.eof() && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos]) || strings.ContainsRune("_.$:/-", p.src[p.pos])) {
+			p.next()
+		}
+		if start == p.pos {
+			return "", p.errorf("unexpected %q", p.src[p.pos])
+		}
+		return string(p.src[start:p.pos]), nil
+	}
+
+	p.next()
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf("unterminated string")
+		}
+		r := p.next()
+		switch r {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", p.errorf("unterminated string")
+			}
+			if err := p.escape(&b); err != nil {
+				return "", err
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
+
+func (p *stringsParser) escape(b *strings.Builder) error {
+	r := p.next()
+	switch r {
+	case 'n':
+		b.WriteByte('\n')
+	case 't':
+		b.WriteByte('\t')
+	case 'r':
+		b.WriteByte('\r')
+	case '0':
+		b.WriteByte(0)
+	case 'U', 'u':
+		if p.pos+4 > len(p.src) {
+			return p.errorf("invalid unicode escape")
+		}
+		u, err := strconv.ParseUint(string(p.src[p.pos:p.pos+4]), 16, 32)
+		if err != nil {
+			return p.errorf("invalid unicode escape")
+		}
+		p.pos += 4
+		b.WriteRune(rune(u))
+	default:
+		b.WriteRune(r)
+	}
+
+	return nil
+}
+
+func quoteStrings(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString("\\\"")
+		case '\\':
+			b.WriteString("\\\\")
+		case '\n':
+			b.WriteString("\\n")
+		case '\t':
+			b.WriteString("\\t")
+		case '\r':
+			b.WriteString("\\r")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// decodeBOM converts UTF-16 content with byte order mark to string, other content is treated as UTF-8
+func decodeBOM(data []byte) string {
+	var bigEndian bool
+	switch {
+	case bytes.HasPrefix(data, []byte{0xfe, 0xff}):
+		bigEndian = true
+	case bytes.HasPrefix(data, []byte{0xff, 0xfe}):
+		bigEndian = false
+	default:
+		return string(bytes.TrimPrefix(data, []byte{0xef, 0xbb, 0xbf}))
+	}
+
+	data = data[2:]
+	units := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		if bigEndian {
+			units = append(units, uint16(data[i])<<8|uint16(data[i+1]))
+		} else {
+			units = append(units, uint16(data[i+1])<<8|uint16(data[i]))
+		}
+	}
+
+	return string(utf16.Decode(units))
+}
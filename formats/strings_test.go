@@ -0,0 +1,58 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testStrings = `/* Title of home screen */
+"home.title" = "Hello \"%@\"";
+
+// Button
+"button.ok" = "OK\nnow";
+unquoted_key = "\U017c";
+`
+
+func TestStringsDecode(t *testing.T) {
+	c, err := ParseString(IOSStrings, testStrings)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Message{
+		&Message{Key: "home.title", Value: "Hello \"%@\"", Comments: []string{"Title of home screen"}},
+		&Message{Key: "button.ok", Value: "OK\nnow", Comments: []string{"Button"}},
+		&Message{Key: "unquoted_key", Value: "ż"},
+	}, c.Messages)
+
+	for _, invalid := range []string{`"a" = "b"`, `"a" "b";`, `"a" = "b`, `/* a`, `"a" = ;`} {
+		_, err = ParseString(IOSStrings, invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestStringsDecodeUTF16(t *testing.T) {
+	units := utf16.Encode([]rune(`"a" = "ż";`))
+	data := []byte{0xff, 0xfe}
+	for _, u := range units {
+		data = append(data, byte(u), byte(u>>8))
+	}
+
+	c, err := Parse(IOSStrings, bytes.NewBuffer(data))
+	assert.Nil(t, err)
+	assert.Equal(t, []*Message{&Message{Key: "a", Value: "ż"}}, c.Messages)
+}
+
+func TestStringsEncode(t *testing.T) {
+	c := NewCatalog("en")
+	c.Add(&Message{Key: "home.title", Value: "Hello \"%@\"", Comments: []string{"Title of home screen"}})
+	c.Add(&Message{Key: "button.ok", Value: "OK\nnow"})
+
+	var b bytes.Buffer
+	err := Write(IOSStrings, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, "/* Title of home screen */\n\"home.title\" = \"Hello \\\"%@\\\"\";\n\n\"button.ok\" = \"OK\\nnow\";\n", b.String())
+
+	c.Add(&Message{Key: "items", Plural: map[string]string{Other: "items"}})
+	assert.NotNil(t, Write(IOSStrings, &b, c))
+}
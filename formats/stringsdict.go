@@ -0,0 +1,204 @@
+package formats
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// IOSStringsdict is a format of iOS .stringsdict plist files
+const IOSStringsdict Format = "IOS_STRINGSDICT_XML"
+
+// Flags of stringsdict messages keeping plural variable name and format value type
+const (
+	stringsdictVariableFlag  = "variable:"
+	stringsdictValueTypeFlag = "value-type:"
+)
+
+var stringsdictVariable = regexp.MustCompile(`%(?:\d+\$)?#@([^@]+)@`)
+
+// StringsdictCodec is a codec of iOS .stringsdict files. Plural variable of NSStringLocalizedFormatKey is stored
+// in Plural of message, format key is stored in Value unless it consists of the variable only. Additional
+// variables are stored as messages with "key@variable" keys.
+type StringsdictCodec struct{}
+
+// plistDict is an ordered plist dictionary, values are strings or *plistDict
+type plistDict struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// Decode parses .stringsdict file
+func (s *StringsdictCodec) Decode(r io.Reader) (*Catalog, error) {
+	root, err := decodePlist(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewCatalog("")
+	for _, key := range root.keys {
+		entry, ok := root.values[key].(*plistDict)
+		if !ok {
+			return nil, fmt.Errorf("stringsdict: %s is not a dict", key)
+		}
+		format, _ := entry.values["NSStringLocalizedFormatKey"].(string)
+		matches := stringsdictVariable.FindAllStringSubmatch(format, -1)
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("stringsdict: %s has no plural variable in format %q", key, format)
+		}
+
+		for i, match := range matches {
+			spec, ok := entry.values[match[1]].(*plistDict)
+			if !ok {
+				return nil, fmt.Errorf("stringsdict: %s has no definition of variable %s", key, match[1])
+			}
+
+			m := &Message{Key: key, Plural: map[string]string{}}
+			if i > 0 {
+				m.Key = key + "@" + match[1]
+			} else if format != match[0] {
+				m.Value = format
+			}
+			m.Flags = []string{stringsdictVariableFlag + match[1]}
+			if t, _ := spec.values["NSStringFormatValueTypeKey"].(string); t != "" {
+				m.Flags = append(m.Flags, stringsdictValueTypeFlag+t)
+			}
+			for _, category := range spec.keys {
+				if isPluralCategory(category) {
+					m.Plural[category], _ = spec.values[category].(string)
+				}
+			}
+			c.Add(m)
+		}
+	}
+
+	return c, nil
+}
+
+// Encode writes catalog as .stringsdict file, messages without plural forms are skipped
+func (s *StringsdictCodec) Encode(w io.Writer, c *Catalog) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+`)
+
+	for _, m := range c.Messages {
+		if !m.IsPlural() || strings.Contains(m.Key, "@") {
+			continue
+		}
+
+		variables := []*Message{m}
+		format := m.Value
+		if format == "" {
+			format = "%#@" + flagValue(m, stringsdictVariableFlag, "value") + "@"
+		}
+		matches := stringsdictVariable.FindAllStringSubmatch(format, -1)
+		if len(matches) == 0 {
+			return fmt.Errorf("stringsdict: %s has no plural variable in format %q", m.Key, format)
+		}
+		for _, match := range matches[1:] {
+			extra, ok := c.Get(m.Key + "@" + match[1])
+			if !ok {
+				return fmt.Errorf("stringsdict: variable %s of %s not found", match[1], m.Key)
+			}
+			variables = append(variables, extra)
+		}
+
+		fmt.Fprintf(bw, "    <key>%s</key>\n    <dict>\n", escapeXMLText(m.Key))
+		fmt.Fprintf(bw, "        <key>NSStringLocalizedFormatKey</key>\n        <string>%s</string>\n", escapeXMLText(format))
+		for i, v := range variables {
+			name := matches[i][1]
+			fmt.Fprintf(bw, "        <key>%s</key>\n        <dict>\n", escapeXMLText(name))
+			bw.WriteString("            <key>NSStringFormatSpecTypeKey</key>\n            <string>NSStringPluralRuleType</string>\n")
+			fmt.Fprintf(bw, "            <key>NSStringFormatValueTypeKey</key>\n            <string>%s</string>\n", escapeXMLText(flagValue(v, stringsdictValueTypeFlag, "d")))
+			for _, category := range PluralCategories {
+				if value, ok := v.Plural[category]; ok {
+					fmt.Fprintf(bw, "            <key>%s</key>\n            <string>%s</string>\n", category, escapeXMLText(value))
+				}
+			}
+			bw.WriteString("        </dict>\n")
+		}
+		bw.WriteString("    </dict>\n")
+	}
+
+	bw.WriteString("</dict>\n</plist>\n")
+	return bw.Flush()
+}
+
+// flagValue returns value of flag with prefix, def is returned when message has no such flag
+func flagValue(m *Message, prefix, def string) string {
+	for _, f := range m.Flags {
+		if strings.HasPrefix(f, prefix) {
+			return strings.TrimPrefix(f, prefix)
+		}
+	}
+
+	return def
+}
+
+// decodePlist parses plist document with dict as root object
+func decodePlist(r io.Reader) (*plistDict, error) {
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("plist: %s", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local == "plist" {
+			continue
+		}
+		if start.Name.Local != "dict" {
+			return nil, fmt.Errorf("plist: expected dict at top level, got %s", start.Name.Local)
+		}
+		return decodePlistDict(dec)
+	}
+}
+
+func decodePlistDict(dec *xml.Decoder) (*plistDict, error) {
+	d := &plistDict{values: map[string]interface{}{}}
+	key := ""
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("plist: %s", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return d, nil
+		case xml.StartElement:
+			var value interface{}
+			switch t.Name.Local {
+			case "key":
+				if err := dec.DecodeElement(&key, &t); err != nil {
+					return nil, err
+				}
+				continue
+			case "dict":
+				if value, err = decodePlistDict(dec); err != nil {
+					return nil, err
+				}
+			case "true", "false":
+				value = t.Name.Local
+				dec.Skip()
+			default:
+				var s string
+				if err := dec.DecodeElement(&s, &t); err != nil {
+					return nil, err
+				}
+				value = s
+			}
+
+			if _, ok := d.values[key]; !ok {
+				d.keys = append(d.keys, key)
+			}
+			d.values[key] = value
+		}
+	}
+}
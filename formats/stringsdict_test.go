@@ -0,0 +1,117 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testStringsdict = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+    <key>items</key>
+    <dict>
+        <key>NSStringLocalizedFormatKey</key>
+        <string>%#@items@</string>
+        <key>items</key>
+        <dict>
+            <key>NSStringFormatSpecTypeKey</key>
+            <string>NSStringPluralRuleType</string>
+            <key>NSStringFormatValueTypeKey</key>
+            <string>d</string>
+            <key>one</key>
+            <string>%d item</string>
+            <key>other</key>
+            <string>%d items</string>
+        </dict>
+    </dict>
+    <key>summary</key>
+    <dict>
+        <key>NSStringLocalizedFormatKey</key>
+        <string>%#@files@ in %#@folders@</string>
+        <key>files</key>
+        <dict>
+            <key>NSStringFormatSpecTypeKey</key>
+            <string>NSStringPluralRuleType</string>
+            <key>NSStringFormatValueTypeKey</key>
+            <string>d</string>
+            <key>one</key>
+            <string>%d file</string>
+            <key>other</key>
+            <string>%d files</string>
+        </dict>
+        <key>folders</key>
+        <dict>
+            <key>NSStringFormatSpecTypeKey</key>
+            <string>NSStringPluralRuleType</string>
+            <key>NSStringFormatValueTypeKey</key>
+            <string>ld</string>
+            <key>one</key>
+            <string>%ld folder</string>
+            <key>other</key>
+            <string>%ld folders</string>
+        </dict>
+    </dict>
+</dict>
+</plist>
+`
+
+func TestStringsdictDecode(t *testing.T) {
+	c, err := ParseString(IOSStringsdict, testStringsdict)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Message{
+		&Message{Key: "items", Plural: map[string]string{One: "%d item", Other: "%d items"}, Flags: []string{"variable:items", "value-type:d"}},
+		&Message{Key: "summary", Value: "%#@files@ in %#@folders@", Plural: map[string]string{One: "%d file", Other: "%d files"}, Flags: []string{"variable:files", "value-type:d"}},
+		&Message{Key: "summary@folders", Plural: map[string]string{One: "%ld folder", Other: "%ld folders"}, Flags: []string{"variable:folders", "value-type:ld"}},
+	}, c.Messages)
+
+	for _, invalid := range []string{
+		`<plist><array></array></plist>`,
+		`<plist><dict><key>a</key><string>b</string></dict></plist>`,
+		`<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>plain</string></dict></dict></plist>`,
+		`<plist><dict><key>a</key><dict><key>NSStringLocalizedFormatKey</key><string>%#@x@</string></dict></dict></plist>`,
+		`<plist><dict>`,
+	} {
+		_, err := ParseString(IOSStringsdict, invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestStringsdictRoundTrip(t *testing.T) {
+	c, err := ParseString(IOSStringsdict, testStringsdict)
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	err = Write(IOSStringsdict, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, testStringsdict, b.String())
+}
+
+func TestStringsdictFromAndroid(t *testing.T) {
+	c, err := ParseString(AndroidXML, `<resources><string name="title">Title</string><plurals name="items"><item quantity="one">%d item</item><item quantity="other">%d items</item></plurals></resources>`)
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	err = Write(IOSStringsdict, &b, c)
+	assert.Nil(t, err)
+
+	back, err := ParseString(IOSStringsdict, b.String())
+	assert.Nil(t, err)
+	assert.Equal(t, []*Message{
+		&Message{Key: "items", Plural: map[string]string{One: "%d item", Other: "%d items"}, Flags: []string{"variable:value", "value-type:d"}},
+	}, back.Messages)
+
+	c.Add(&Message{Key: "broken", Value: "%#@a@ %#@b@", Plural: map[string]string{Other: "a"}})
+	assert.NotNil(t, Write(IOSStringsdict, &b, c))
+}
+
+func TestStringsdictEncodeWithoutVariable(t *testing.T) {
+	c := NewCatalog("en")
+	c.Add(&Message{Key: "files", Value: "%d files", Plural: map[string]string{One: "%d file", Other: "%d files"}})
+
+	var b bytes.Buffer
+	err := Write(IOSStringsdict, &b, c)
+	assert.Equal(t, `stringsdict: files has no plural variable in format "%d files"`, err.Error())
+}
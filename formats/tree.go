@@ -0,0 +1,103 @@
+package formats
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tree is an ordered map used by hierarchical formats, values are strings or *tree
+type tree struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newTree() *tree {
+	return &tree{values: map[string]interface{}{}}
+}
+
+func (t *tree) set(key string, value interface{}) {
+	if _, ok := t.values[key]; !ok {
+		t.keys = append(t.keys, key)
+	}
+	t.values[key] = value
+}
+
+// isPlural returns whether all keys of tree are CLDR plural categories with string values
+func (t *tree) isPlural() bool {
+	if _, ok := t.values[Other]; !ok {
+		return false
+	}
+	for _, k := range t.keys {
+		if _, ok := t.values[k].(string); !ok || !isPluralCategory(k) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flatten adds leaves of tree to catalog as messages with keys joined by separator
+func (t *tree) flatten(c *Catalog, prefix []string, separator string) {
+	for _, k := range t.keys {
+		path := append(append([]string{}, prefix...), k)
+		key := strings.Join(path, separator)
+		switch v := t.values[k].(type) {
+		case string:
+			c.Add(&Message{Key: key, Value: v})
+		case *tree:
+			if v.isPlural() {
+				m := &Message{Key: key, Plural: map[string]string{}}
+				for _, category := range v.keys {
+					m.Plural[category] = v.values[category].(string)
+				}
+				c.Add(m)
+				continue
+			}
+			v.flatten(c, path, separator)
+		}
+	}
+}
+
+// unflatten builds tree from catalog splitting keys by separator
+func unflatten(c *Catalog, separator string) (*tree, error) {
+	root := newTree()
+	for _, m := range c.Messages {
+		path := []string{m.Key}
+		if separator != "" {
+			path = strings.Split(m.Key, separator)
+		}
+
+		node := root
+		for _, k := range path[:len(path)-1] {
+			switch v := node.values[k].(type) {
+			case nil:
+				sub := newTree()
+				node.set(k, sub)
+				node = sub
+			case *tree:
+				node = v
+			default:
+				return nil, fmt.Errorf("key %s conflicts with value of %s", m.Key, k)
+			}
+		}
+
+		leaf := path[len(path)-1]
+		if _, ok := node.values[leaf]; ok {
+			return nil, fmt.Errorf("duplicate key %s", m.Key)
+		}
+		if !m.IsPlural() {
+			node.set(leaf, m.Value)
+			continue
+		}
+
+		plural := newTree()
+		for _, category := range PluralCategories {
+			if v, ok := m.Plural[category]; ok {
+				plural.set(category, v)
+			}
+		}
+		node.set(leaf, plural)
+	}
+
+	return root, nil
+}
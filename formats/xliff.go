@@ -0,0 +1,650 @@
+package formats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+// XLIFF formats, XLIFF is a name of XLIFF 1.2 format in OneSky
+const (
+	XLIFF  Format = "XLIFF"
+	XLIFF2 Format = "XLIFF_2"
+)
+
+// Header fields of XLIFF catalogs
+const (
+	XLIFFSourceLanguage = "Source-Language"
+	XLIFFOriginal       = "Original"
+	XLIFFDatatype       = "Datatype"
+)
+
+// xliffStateFlag is a prefix of flag which keeps state of translation
+const xliffStateFlag = "state:"
+
+// plural groups of XLIFF 1.2 and 2.0 files
+const (
+	xliff12PluralGroup = "x-gettext-plurals"
+	xliff20PluralGroup = "onesky:plurals"
+)
+
+// xliff12ContextType is a context-type of XLIFF 1.2 context which keeps context of message
+const xliff12ContextType = "x-gettext-msgctxt"
+
+var xliffPluralID = regexp.MustCompile(`^(.+)\[([a-z]+)\]$`)
+
+// XLIFFCodec is a codec of XLIFF 1.2 and 2.0 files. Decode accepts both versions, Encode writes Version.
+// Source text is stored in Source of message, target text in Value, state in "state:" flag and notes
+// in Comments. Plural messages are written as groups of units with "key[category]" ids. Messages with context
+// have "context|key" id and key in resname (name in 2.0), XLIFF 1.2 keeps context also in <context-group>.
+type XLIFFCodec struct {
+	// Version is a version of written files, "1.2" or "2.0"
+	Version string
+}
+
+type xliffText struct {
+	State string `xml:"state,attr"`
+	Inner string `xml:",innerxml"`
+}
+
+type xliff12Doc struct {
+	Version string        `xml:"version,attr"`
+	Files   []xliff12File `xml:"file"`
+}
+
+type xliff12File struct {
+	SourceLanguage string `xml:"source-language,attr"`
+	TargetLanguage string `xml:"target-language,attr"`
+	Original       string `xml:"original,attr"`
+	Datatype       string `xml:"datatype,attr"`
+	Body           struct {
+		Elements []xliff12Element `xml:",any"`
+	} `xml:"body"`
+}
+
+// xliff12Element is a <trans-unit> or <group> element
+type xliff12Element struct {
+	XMLName  xml.Name
+	ID       string           `xml:"id,attr"`
+	Resname  string           `xml:"resname,attr"`
+	Restype  string           `xml:"restype,attr"`
+	Source   xliffText        `xml:"source"`
+	Target   *xliffText       `xml:"target"`
+	Notes    []string         `xml:"note"`
+	Contexts []xliff12Context `xml:"context-group>context"`
+	Elements []xliff12Element `xml:",any"`
+}
+
+type xliff12Context struct {
+	Type  string `xml:"context-type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xliff20Doc struct {
+	Version string        `xml:"version,attr"`
+	SrcLang string        `xml:"srcLang,attr"`
+	TrgLang string        `xml:"trgLang,attr"`
+	Files   []xliff20File `xml:"file"`
+}
+
+type xliff20File struct {
+	ID       string           `xml:"id,attr"`
+	Elements []xliff20Element `xml:",any"`
+}
+
+// xliff20Element is a <unit> or <group> element
+type xliff20Element struct {
+	XMLName  xml.Name
+	ID       string           `xml:"id,attr"`
+	Name     string           `xml:"name,attr"`
+	Type     string           `xml:"type,attr"`
+	Notes    []string         `xml:"notes>note"`
+	Segments []xliff20Segment `xml:"segment"`
+	Elements []xliff20Element `xml:",any"`
+}
+
+type xliff20Segment struct {
+	State  string     `xml:"state,attr"`
+	Source xliffText  `xml:"source"`
+	Target *xliffText `xml:"target"`
+}
+
+// Decode parses XLIFF 1.2 or 2.0 file
+func (x *XLIFFCodec) Decode(r io.Reader) (*Catalog, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var probe struct {
+		XMLName xml.Name
+		Version string `xml:"version,attr"`
+	}
+	if err := xml.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("xliff: %s", err)
+	}
+	if probe.XMLName.Local != "xliff" {
+		return nil, fmt.Errorf("xliff: expected <xliff>, got <%s>", probe.XMLName.Local)
+	}
+
+	switch probe.Version {
+	case "1.0", "1.1", "1.2":
+		return decodeXLIFF12(data)
+	case "2.0", "2.1":
+		return decodeXLIFF20(data)
+	}
+
+	return nil, fmt.Errorf("xliff: version %q not supported", probe.Version)
+}
+
+func decodeXLIFF12(data []byte) (*Catalog, error) {
+	doc := xliff12Doc{}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("xliff: %s", err)
+	}
+
+	c := NewCatalog("")
+	for i, f := range doc.Files {
+		if i == 0 {
+			c.Locale = f.TargetLanguage
+			setHeaderIfNotEmpty(c, XLIFFSourceLanguage, f.SourceLanguage)
+			setHeaderIfNotEmpty(c, XLIFFOriginal, f.Original)
+			setHeaderIfNotEmpty(c, XLIFFDatatype, f.Datatype)
+		}
+		if err := decodeXLIFF12Elements(c, f.Body.Elements); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func decodeXLIFF12Elements(c *Catalog, elements []xliff12Element) error {
+	for _, e := range elements {
+		switch e.XMLName.Local {
+		case "trans-unit":
+			m := &Message{Key: xliffKey(e.ID, e.Resname), Context: xliff12MessageContext(e), Source: decodeXLIFFText(e.Source.Inner), Comments: e.Notes}
+			if e.Target != nil {
+				m.Value = decodeXLIFFText(e.Target.Inner)
+				m.Flags = xliffStateFlags(e.Target.State)
+			}
+			c.Add(m)
+		case "group":
+			if e.Restype != xliff12PluralGroup {
+				if err := decodeXLIFF12Elements(c, e.Elements); err != nil {
+					return err
+				}
+				continue
+			}
+
+			m := &Message{Key: xliffKey(e.ID, e.Resname), Context: xliff12MessageContext(e), Plural: map[string]string{}, SourcePlural: map[string]string{}, Comments: e.Notes}
+			for _, unit := range e.Elements {
+				if unit.XMLName.Local != "trans-unit" {
+					continue
+				}
+				category, err := xliffPluralCategory(e.ID, m.Key, xliffKey(unit.ID, unit.Resname))
+				if err != nil {
+					return err
+				}
+				m.SourcePlural[category] = decodeXLIFFText(unit.Source.Inner)
+				if unit.Target != nil {
+					m.Plural[category] = decodeXLIFFText(unit.Target.Inner)
+					if m.Flags == nil {
+						m.Flags = xliffStateFlags(unit.Target.State)
+					}
+				}
+				m.Comments = append(m.Comments, unit.Notes...)
+			}
+			c.Add(m)
+		}
+	}
+
+	return nil
+}
+
+func decodeXLIFF20(data []byte) (*Catalog, error) {
+	doc := xliff20Doc{}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("xliff: %s", err)
+	}
+
+	c := NewCatalog(doc.TrgLang)
+	setHeaderIfNotEmpty(c, XLIFFSourceLanguage, doc.SrcLang)
+	for i, f := range doc.Files {
+		if i == 0 {
+			setHeaderIfNotEmpty(c, XLIFFOriginal, f.ID)
+		}
+		if err := decodeXLIFF20Elements(c, f.Elements); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func decodeXLIFF20Elements(c *Catalog, elements []xliff20Element) error {
+	for _, e := range elements {
+		switch e.XMLName.Local {
+		case "unit":
+			m := &Message{Key: xliffKey(e.ID, e.Name), Context: xliff20MessageContext(e), Comments: e.Notes}
+			source, target, state, ok := joinXLIFF20Segments(e.Segments)
+			m.Source = source
+			if ok {
+				m.Value = target
+				m.Flags = xliffStateFlags(state)
+			}
+			c.Add(m)
+		case "group":
+			if e.Type != xliff20PluralGroup {
+				if err := decodeXLIFF20Elements(c, e.Elements); err != nil {
+					return err
+				}
+				continue
+			}
+
+			m := &Message{Key: xliffKey(e.ID, e.Name), Context: xliff20MessageContext(e), Plural: map[string]string{}, SourcePlural: map[string]string{}, Comments: e.Notes}
+			for _, unit := range e.Elements {
+				if unit.XMLName.Local != "unit" {
+					continue
+				}
+				category, err := xliffPluralCategory(e.ID, m.Key, xliffKey(unit.ID, unit.Name))
+				if err != nil {
+					return err
+				}
+				source, target, state, ok := joinXLIFF20Segments(unit.Segments)
+				m.SourcePlural[category] = source
+				if ok {
+					m.Plural[category] = target
+					if m.Flags == nil {
+						m.Flags = xliffStateFlags(state)
+					}
+				}
+				m.Comments = append(m.Comments, unit.Notes...)
+			}
+			c.Add(m)
+		}
+	}
+
+	return nil
+}
+
+// joinXLIFF20Segments concatenates segments of unit, state of the first segment is returned
+func joinXLIFF20Segments(segments []xliff20Segment) (string, string, string, bool) {
+	source, target, state, ok := "", "", "", false
+	for i, s := range segments {
+		source += decodeXLIFFText(s.Source.Inner)
+		if s.Target != nil {
+			target += decodeXLIFFText(s.Target.Inner)
+			ok = true
+		}
+		if i == 0 {
+			state = s.State
+		}
+	}
+
+	return source, target, state, ok
+}
+
+// Encode writes catalog as XLIFF file. Messages without Source are written as source text without target.
+func (x *XLIFFCodec) Encode(w io.Writer, c *Catalog) error {
+	version := x.Version
+	if version == "" {
+		version = "1.2"
+	}
+
+	bw := bufio.NewWriter(w)
+	switch version {
+	case "1.2":
+		encodeXLIFF12(bw, c)
+	case "2.0":
+		encodeXLIFF20(bw, c)
+	default:
+		return fmt.Errorf("xliff: version %q not supported", version)
+	}
+
+	return bw.Flush()
+}
+
+func encodeXLIFF12(w *bufio.Writer, c *Catalog) {
+	original := c.HeaderValue(XLIFFOriginal)
+	if original == "" {
+		original = "messages"
+	}
+	datatype := c.HeaderValue(XLIFFDatatype)
+	if datatype == "" {
+		datatype = "plaintext"
+	}
+
+	w.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	w.WriteString("<xliff version=\"1.2\" xmlns=\"urn:oasis:names:tc:xliff:document:1.2\">\n")
+	fmt.Fprintf(w, "    <file source-language=\"%s\"", escapeXMLAttr(xliffSourceLanguage(c)))
+	if c.Locale != "" {
+		fmt.Fprintf(w, " target-language=\"%s\"", escapeXMLAttr(c.Locale))
+	}
+	fmt.Fprintf(w, " datatype=\"%s\" original=\"%s\">\n", escapeXMLAttr(datatype), escapeXMLAttr(original))
+	w.WriteString("        <body>\n")
+
+	writeContext := func(indent, context string) {
+		if context == "" {
+			return
+		}
+		fmt.Fprintf(w, "%s<context-group purpose=\"information\">\n", indent)
+		fmt.Fprintf(w, "%s    <context context-type=\"%s\">%s</context>\n", indent, xliff12ContextType, escapeXMLText(context))
+		fmt.Fprintf(w, "%s</context-group>\n", indent)
+	}
+	writeUnit := func(indent, id, nameAttr, context, source, target string, hasTarget bool, state string, notes []string) {
+		fmt.Fprintf(w, "%s<trans-unit id=\"%s\"%s>\n", indent, escapeXMLAttr(id), nameAttr)
+		fmt.Fprintf(w, "%s    <source>%s</source>\n", indent, encodeXLIFFText(source))
+		if hasTarget {
+			attrs := ""
+			if state != "" {
+				attrs = fmt.Sprintf(" state=\"%s\"", xliff12State(state))
+			}
+			fmt.Fprintf(w, "%s    <target%s>%s</target>\n", indent, attrs, encodeXLIFFText(target))
+		}
+		for _, note := range notes {
+			fmt.Fprintf(w, "%s    <note>%s</note>\n", indent, escapeXMLText(note))
+		}
+		writeContext(indent+"    ", context)
+		fmt.Fprintf(w, "%s</trans-unit>\n", indent)
+	}
+
+	for _, m := range c.Messages {
+		state := flagValue(m, xliffStateFlag, "")
+		id := xliffID(m)
+		if !m.IsPlural() {
+			source, target, hasTarget := xliffTexts(m.Source, m.Value)
+			writeUnit("            ", id, xliffNameAttr("resname", m), m.Context, source, target, hasTarget, state, m.Comments)
+			continue
+		}
+
+		fmt.Fprintf(w, "            <group id=\"%s\"%s restype=\"%s\">\n", escapeXMLAttr(id), xliffNameAttr("resname", m), xliff12PluralGroup)
+		for _, note := range m.Comments {
+			fmt.Fprintf(w, "                <note>%s</note>\n", escapeXMLText(note))
+		}
+		writeContext("                ", m.Context)
+		for _, category := range xliffPluralCategories(m) {
+			source, target, hasTarget := xliffPluralTexts(m, category)
+			writeUnit("                ", id+"["+category+"]", "", "", source, target, hasTarget, state, nil)
+		}
+		w.WriteString("            </group>\n")
+	}
+
+	w.WriteString("        </body>\n    </file>\n</xliff>\n")
+}
+
+func encodeXLIFF20(w *bufio.Writer, c *Catalog) {
+	id := c.HeaderValue(XLIFFOriginal)
+	if id == "" {
+		id = "messages"
+	}
+
+	w.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	fmt.Fprintf(w, "<xliff version=\"2.0\" xmlns=\"urn:oasis:names:tc:xliff:document:2.0\" srcLang=\"%s\"", escapeXMLAttr(xliffSourceLanguage(c)))
+	if c.Locale != "" {
+		fmt.Fprintf(w, " trgLang=\"%s\"", escapeXMLAttr(c.Locale))
+	}
+	w.WriteString(">\n")
+	fmt.Fprintf(w, "    <file id=\"%s\">\n", escapeXMLAttr(id))
+
+	writeNotes := func(indent string, notes []string) {
+		if len(notes) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s<notes>\n", indent)
+		for _, note := range notes {
+			fmt.Fprintf(w, "%s    <note>%s</note>\n", indent, escapeXMLText(note))
+		}
+		fmt.Fprintf(w, "%s</notes>\n", indent)
+	}
+	writeUnit := func(indent, id, nameAttr, source, target string, hasTarget bool, state string, notes []string) {
+		fmt.Fprintf(w, "%s<unit id=\"%s\"%s>\n", indent, escapeXMLAttr(id), nameAttr)
+		writeNotes(indent+"    ", notes)
+		attrs := ""
+		if hasTarget && state != "" {
+			attrs = fmt.Sprintf(" state=\"%s\"", xliff20State(state))
+		}
+		fmt.Fprintf(w, "%s    <segment%s>\n", indent, attrs)
+		fmt.Fprintf(w, "%s        <source>%s</source>\n", indent, encodeXLIFFText(source))
+		if hasTarget {
+			fmt.Fprintf(w, "%s        <target>%s</target>\n", indent, encodeXLIFFText(target))
+		}
+		fmt.Fprintf(w, "%s    </segment>\n", indent)
+		fmt.Fprintf(w, "%s</unit>\n", indent)
+	}
+
+	for _, m := range c.Messages {
+		state := flagValue(m, xliffStateFlag, "")
+		id := xliffID(m)
+		if !m.IsPlural() {
+			source, target, hasTarget := xliffTexts(m.Source, m.Value)
+			writeUnit("        ", id, xliffNameAttr("name", m), source, target, hasTarget, state, m.Comments)
+			continue
+		}
+
+		fmt.Fprintf(w, "        <group id=\"%s\"%s type=\"%s\">\n", escapeXMLAttr(id), xliffNameAttr("name", m), xliff20PluralGroup)
+		writeNotes("            ", m.Comments)
+		for _, category := range xliffPluralCategories(m) {
+			source, target, hasTarget := xliffPluralTexts(m, category)
+			writeUnit("            ", id+"["+category+"]", "", source, target, hasTarget, state, nil)
+		}
+		w.WriteString("        </group>\n")
+	}
+
+	w.WriteString("    </file>\n</xliff>\n")
+}
+
+// Bilingual returns catalog with source texts of source catalog and translations of target catalog,
+// it can be written as XLIFF file for translators. Messages of target catalog without source are skipped.
+func Bilingual(source, target *Catalog) *Catalog {
+	c := NewCatalog(target.Locale)
+	c.SetHeader(XLIFFSourceLanguage, source.Locale)
+
+	for _, s := range source.Messages {
+		m := &Message{
+			Key:               s.Key,
+			Context:           s.Context,
+			Source:            s.Value,
+			SourcePlural:      s.Plural,
+			Comments:          s.Comments,
+			ExtractedComments: s.ExtractedComments,
+			References:        s.References,
+		}
+
+		state := "needs-translation"
+		if t, ok := target.Lookup(s.Context, s.Key); ok {
+			m.Value, m.Plural = t.Value, t.Plural
+			if t.Value != "" || len(t.Plural) > 0 {
+				state = "translated"
+			}
+		}
+		m.Flags = []string{xliffStateFlag + state}
+		c.Add(m)
+	}
+
+	return c
+}
+
+// xliff12States maps XLIFF 2.0 states to XLIFF 1.2 states
+var xliff12States = map[string]string{
+	"initial":  "needs-translation",
+	"reviewed": "signed-off",
+}
+
+// xliff20States maps XLIFF 1.2 states to XLIFF 2.0 states
+var xliff20States = map[string]string{
+	"new":                      "initial",
+	"needs-translation":        "initial",
+	"needs-adaptation":         "initial",
+	"needs-l10n":               "initial",
+	"needs-review-translation": "translated",
+	"needs-review-adaptation":  "translated",
+	"needs-review-l10n":        "translated",
+	"signed-off":               "reviewed",
+}
+
+func xliff12State(state string) string {
+	if s, ok := xliff12States[state]; ok {
+		return s
+	}
+
+	return escapeXMLAttr(state)
+}
+
+func xliff20State(state string) string {
+	if s, ok := xliff20States[state]; ok {
+		return s
+	}
+
+	return escapeXMLAttr(state)
+}
+
+func xliffStateFlags(state string) []string {
+	if state == "" {
+		return nil
+	}
+
+	return []string{xliffStateFlag + state}
+}
+
+// xliffSourceLanguage returns source language of catalog, locale of catalog is used for monolingual catalogs
+func xliffSourceLanguage(c *Catalog) string {
+	if l := c.HeaderValue(XLIFFSourceLanguage); l != "" {
+		return l
+	}
+
+	return c.Locale
+}
+
+// xliffTexts returns source and target of message, value of monolingual message is a source text
+func xliffTexts(source, value string) (string, string, bool) {
+	if source == "" {
+		return value, "", false
+	}
+
+	return source, value, true
+}
+
+func xliffPluralTexts(m *Message, category string) (string, string, bool) {
+	if len(m.SourcePlural) == 0 {
+		return m.Plural[category], "", false
+	}
+
+	source, ok := m.SourcePlural[category]
+	if !ok {
+		source = m.SourcePlural[Other]
+	}
+	target, ok := m.Plural[category]
+
+	return source, target, ok
+}
+
+// xliffPluralCategories returns categories of source and target plural forms in canonical order
+func xliffPluralCategories(m *Message) []string {
+	var categories []string
+	for _, category := range PluralCategories {
+		_, inSource := m.SourcePlural[category]
+		_, inTarget := m.Plural[category]
+		if inSource || inTarget {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
+
+// xliffPluralCategory returns category of plural unit, unit id is "groupID[category]" or "key[category]"
+func xliffPluralCategory(groupID, key, id string) (string, error) {
+	match := xliffPluralID.FindStringSubmatch(id)
+	if match == nil || (match[1] != groupID && match[1] != key) || !isPluralCategory(match[2]) {
+		return "", fmt.Errorf("xliff: invalid plural unit %s of %s", id, key)
+	}
+
+	return match[2], nil
+}
+
+// xliffID returns id of unit or plural group of message, id of message with context is "context|key"
+func xliffID(m *Message) string {
+	if m.Context == "" {
+		return m.Key
+	}
+
+	return m.Context + "|" + m.Key
+}
+
+// xliffNameAttr returns attribute with key of message with context, key of message without context is its id
+func xliffNameAttr(name string, m *Message) string {
+	if m.Context == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(" %s=\"%s\"", name, escapeXMLAttr(m.Key))
+}
+
+// xliff12MessageContext returns context of message from <context-group> of unit or group
+func xliff12MessageContext(e xliff12Element) string {
+	for _, c := range e.Contexts {
+		if c.Type == xliff12ContextType {
+			return c.Value
+		}
+	}
+
+	return ""
+}
+
+// xliff20MessageContext returns context of message from "context|key" id of unit or group with key in name
+func xliff20MessageContext(e xliff20Element) string {
+	if e.Name == "" || !strings.HasSuffix(e.ID, "|"+e.Name) {
+		return ""
+	}
+
+	return strings.TrimSuffix(e.ID, "|"+e.Name)
+}
+
+// xliffKey returns resource name of unit, id is used when name is not set
+func xliffKey(id, name string) string {
+	if name != "" {
+		return name
+	}
+
+	return id
+}
+
+// decodeXLIFFText converts inner XML of source or target to string, inline markup is kept as is
+func decodeXLIFFText(inner string) string {
+	dec := xml.NewDecoder(strings.NewReader("<x>" + inner + "</x>"))
+	var b bytes.Buffer
+	for depth := 0; ; {
+		tok, err := dec.Token()
+		if err != nil {
+			return b.String()
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth++; depth > 1 {
+				return inner
+			}
+		case xml.CharData:
+			b.Write(t)
+		}
+	}
+}
+
+func encodeXLIFFText(s string) string {
+	if hasMarkup(s) {
+		return s
+	}
+
+	return escapeXMLText(s)
+}
+
+func setHeaderIfNotEmpty(c *Catalog, name, value string) {
+	if value != "" {
+		c.SetHeader(name, value)
+	}
+}
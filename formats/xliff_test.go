@@ -0,0 +1,261 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testXLIFF12 = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+    <file source-language="en" target-language="de" datatype="plaintext" original="messages.json">
+        <body>
+            <trans-unit id="title">
+                <source>Title &amp; more</source>
+                <target state="translated">Titel &amp; mehr</target>
+                <note>Page title</note>
+            </trans-unit>
+            <trans-unit id="greeting">
+                <source>Hello <g id="1">%s</g></source>
+                <target state="needs-review-translation">Hallo <g id="1">%s</g></target>
+            </trans-unit>
+            <group id="items" restype="x-gettext-plurals">
+                <note>Number of items</note>
+                <trans-unit id="items[one]">
+                    <source>%d item</source>
+                    <target state="final">%d Artikel</target>
+                </trans-unit>
+                <trans-unit id="items[other]">
+                    <source>%d items</source>
+                    <target state="final">%d Artikel</target>
+                </trans-unit>
+            </group>
+        </body>
+    </file>
+</xliff>
+`
+
+const testXLIFF20 = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="2.0" xmlns="urn:oasis:names:tc:xliff:document:2.0" srcLang="en" trgLang="de">
+    <file id="messages.json">
+        <unit id="title">
+            <notes>
+                <note>Page title</note>
+            </notes>
+            <segment state="translated">
+                <source>Title &amp; more</source>
+                <target>Titel &amp; mehr</target>
+            </segment>
+        </unit>
+        <unit id="greeting">
+            <segment state="translated">
+                <source>Hello <g id="1">%s</g></source>
+                <target>Hallo <g id="1">%s</g></target>
+            </segment>
+        </unit>
+        <group id="items" type="onesky:plurals">
+            <notes>
+                <note>Number of items</note>
+            </notes>
+            <unit id="items[one]">
+                <segment state="final">
+                    <source>%d item</source>
+                    <target>%d Artikel</target>
+                </segment>
+            </unit>
+            <unit id="items[other]">
+                <segment state="final">
+                    <source>%d items</source>
+                    <target>%d Artikel</target>
+                </segment>
+            </unit>
+        </group>
+    </file>
+</xliff>
+`
+
+func TestXLIFFDecode(t *testing.T) {
+	c, err := ParseString(XLIFF, testXLIFF12)
+	assert.Nil(t, err)
+	assert.Equal(t, "de", c.Locale)
+	assert.Equal(t, "en", c.HeaderValue(XLIFFSourceLanguage))
+	assert.Equal(t, "messages.json", c.HeaderValue(XLIFFOriginal))
+	assert.Equal(t, []*Message{
+		&Message{Key: "title", Source: "Title & more", Value: "Titel & mehr", Comments: []string{"Page title"}, Flags: []string{"state:translated"}},
+		&Message{Key: "greeting", Source: `Hello <g id="1">%s</g>`, Value: `Hallo <g id="1">%s</g>`, Flags: []string{"state:needs-review-translation"}},
+		&Message{
+			Key:          "items",
+			SourcePlural: map[string]string{One: "%d item", Other: "%d items"},
+			Plural:       map[string]string{One: "%d Artikel", Other: "%d Artikel"},
+			Comments:     []string{"Number of items"},
+			Flags:        []string{"state:final"},
+		},
+	}, c.Messages)
+
+	c2, err := ParseString(XLIFF2, testXLIFF20)
+	assert.Nil(t, err)
+	assert.Equal(t, "de", c2.Locale)
+	assert.Equal(t, "en", c2.HeaderValue(XLIFFSourceLanguage))
+	c.Messages[1].Flags = []string{"state:translated"}
+	assert.Equal(t, c.Messages, c2.Messages)
+
+	for _, invalid := range []string{
+		`<resources></resources>`,
+		`<xliff version="3.0"></xliff>`,
+		`<xliff version="1.2"><file><body><group id="a" restype="x-gettext-plurals"><trans-unit id="b[one]"><source>b</source></trans-unit></group></body></file></xliff>`,
+		`<xliff version="2.0"><file><group id="a" type="onesky:plurals"><unit id="a[some]"><segment><source>a</source></segment></unit></group></file></xliff>`,
+		`<xliff version="1.2"><file>`,
+	} {
+		_, err := ParseString(XLIFF, invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestXLIFFRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		format  Format
+		content string
+	}{
+		{XLIFF, testXLIFF12},
+		{XLIFF2, testXLIFF20},
+	} {
+		c, err := ParseString(test.format, test.content)
+		assert.Nil(t, err)
+
+		var b bytes.Buffer
+		err = Write(test.format, &b, c)
+		assert.Nil(t, err)
+		assert.Equal(t, test.content, b.String())
+	}
+}
+
+func TestXLIFFVersionConversion(t *testing.T) {
+	c, err := ParseString(XLIFF, testXLIFF12)
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	err = Write(XLIFF2, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, testXLIFF20, b.String())
+
+	assert.NotNil(t, (&XLIFFCodec{Version: "3.0"}).Encode(&b, c))
+}
+
+func TestBilingual(t *testing.T) {
+	source, err := ParseString(HierarchicalJSON, `{"title": "Title", "body": "Body", "items": {"one": "%d item", "other": "%d items"}}`)
+	assert.Nil(t, err)
+	source.Locale = "en"
+	target, err := ParseString(HierarchicalJSON, `{"title": "Tytuł", "items": {"one": "%d element", "few": "%d elementy", "many": "%d elementów", "other": "%d elementu"}}`)
+	assert.Nil(t, err)
+	target.Locale = "pl"
+
+	c := Bilingual(source, target)
+	var b bytes.Buffer
+	err = Write(XLIFF, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+    <file source-language="en" target-language="pl" datatype="plaintext" original="messages">
+        <body>
+            <trans-unit id="title">
+                <source>Title</source>
+                <target state="translated">Tytuł</target>
+            </trans-unit>
+            <trans-unit id="body">
+                <source>Body</source>
+                <target state="needs-translation"></target>
+            </trans-unit>
+            <group id="items" restype="x-gettext-plurals">
+                <trans-unit id="items[one]">
+                    <source>%d item</source>
+                    <target state="translated">%d element</target>
+                </trans-unit>
+                <trans-unit id="items[few]">
+                    <source>%d items</source>
+                    <target state="translated">%d elementy</target>
+                </trans-unit>
+                <trans-unit id="items[many]">
+                    <source>%d items</source>
+                    <target state="translated">%d elementów</target>
+                </trans-unit>
+                <trans-unit id="items[other]">
+                    <source>%d items</source>
+                    <target state="translated">%d elementu</target>
+                </trans-unit>
+            </group>
+        </body>
+    </file>
+</xliff>
+`, b.String())
+
+	// returned file is written back in format of OneSky file
+	back, err := ParseString(XLIFF, b.String())
+	assert.Nil(t, err)
+	b.Reset()
+	err = Write(HierarchicalJSON, &b, back)
+	assert.Nil(t, err)
+	assert.Equal(t, `{
+  "title": "Tytuł",
+  "body": "",
+  "items": {
+    "one": "%d element",
+    "few": "%d elementy",
+    "many": "%d elementów",
+    "other": "%d elementu"
+  }
+}
+`, b.String())
+}
+
+func TestXLIFFMonolingual(t *testing.T) {
+	c, err := ParseString(JavaProperties, "a=A < B\n")
+	assert.Nil(t, err)
+	c.Locale = "en"
+
+	var b bytes.Buffer
+	err = Write(XLIFF2, &b, c)
+	assert.Nil(t, err)
+	assert.Contains(t, b.String(), `srcLang="en" trgLang="en"`)
+	assert.Contains(t, b.String(), "<segment>\n                <source>A &lt; B</source>\n            </segment>")
+}
+
+func TestXLIFFContext(t *testing.T) {
+	c, err := ParseString(GNUPO, `msgid ""
+msgstr ""
+"Language: de\n"
+
+msgctxt "menu"
+msgid "Open"
+msgstr "Öffnen"
+
+msgid "Open"
+msgstr "Offen"
+
+msgctxt "menu"
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d Datei"
+msgstr[1] "%d Dateien"
+`)
+	assert.Nil(t, err)
+
+	for _, format := range []Format{XLIFF, XLIFF2} {
+		var b bytes.Buffer
+		assert.Nil(t, Write(format, &b, c))
+		assert.Contains(t, b.String(), `id="menu|Open"`)
+
+		decoded, err := ParseString(format, b.String())
+		assert.Nil(t, err)
+		assert.Equal(t, 3, decoded.Len())
+		m, ok := decoded.Lookup("menu", "Open")
+		assert.True(t, ok)
+		assert.Equal(t, "Öffnen", m.Source)
+		m, ok = decoded.Lookup("", "Open")
+		assert.True(t, ok)
+		assert.Equal(t, "Offen", m.Source)
+		m, ok = decoded.Lookup("menu", "%d file")
+		assert.True(t, ok)
+		assert.Equal(t, map[string]string{One: "%d Datei", Other: "%d Dateien"}, m.SourcePlural)
+	}
+}
@@ -0,0 +1,112 @@
+package formats
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+)
+
+// YAMLCodec is a codec of YAML files, nested keys are joined by Separator
+type YAMLCodec struct {
+	Separator string
+	// LocaleRoot is set for Rails style files which have locale as the only top level key
+	LocaleRoot bool
+}
+
+// Decode parses YAML file keeping order of keys
+func (y *YAMLCodec) Decode(r io.Reader) (*Catalog, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := yaml.MapSlice{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	t := yamlMapToTree(doc)
+	c := NewCatalog("")
+	if y.LocaleRoot {
+		if len(t.keys) != 1 {
+			return nil, fmt.Errorf("yaml: expected single locale key at top level, got %d keys", len(t.keys))
+		}
+		sub, ok := t.values[t.keys[0]].(*tree)
+		if !ok {
+			return nil, fmt.Errorf("yaml: expected map under locale key %s", t.keys[0])
+		}
+		c.Locale, t = t.keys[0], sub
+	}
+	t.flatten(c, nil, y.Separator)
+
+	return c, nil
+}
+
+// Encode writes catalog as YAML file
+func (y *YAMLCodec) Encode(w io.Writer, c *Catalog) error {
+	t, err := unflatten(c, y.Separator)
+	if err != nil {
+		return err
+	}
+
+	doc := treeToYAMLMap(t)
+	if y.LocaleRoot {
+		if c.Locale == "" {
+			return fmt.Errorf("yaml: catalog locale is required for locale root")
+		}
+		doc = yaml.MapSlice{yaml.MapItem{Key: c.Locale, Value: doc}}
+	}
+
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func yamlMapToTree(m yaml.MapSlice) *tree {
+	t := newTree()
+	for _, item := range m {
+		t.set(fmt.Sprint(item.Key), yamlValue(item.Value))
+	}
+
+	return t
+}
+
+func yamlValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		return yamlMapToTree(v)
+	case []interface{}:
+		t := newTree()
+		for i, item := range v {
+			t.set(strconv.Itoa(i), yamlValue(item))
+		}
+		return t
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func treeToYAMLMap(t *tree) yaml.MapSlice {
+	m := yaml.MapSlice{}
+	for _, k := range t.keys {
+		switch v := t.values[k].(type) {
+		case string:
+			m = append(m, yaml.MapItem{Key: k, Value: v})
+		case *tree:
+			m = append(m, yaml.MapItem{Key: k, Value: treeToYAMLMap(v)})
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,68 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYAMLDecode(t *testing.T) {
+	c, err := ParseString(RubyYAML, `en:
+  app:
+    title: Hello
+    items:
+      one: "%{count} item"
+      other: "%{count} items"
+  yes: true
+  days:
+    - Mon
+    - Tue
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, "en", c.Locale)
+	assert.Equal(t, []*Message{
+		&Message{Key: "app.title", Value: "Hello"},
+		&Message{Key: "app.items", Plural: map[string]string{One: "%{count} item", Other: "%{count} items"}},
+		&Message{Key: "true", Value: "true"},
+		&Message{Key: "days.0", Value: "Mon"},
+		&Message{Key: "days.1", Value: "Tue"},
+	}, c.Messages)
+
+	c, err = ParseString(YAML, "a:\n  b: c\n")
+	assert.Nil(t, err)
+	assert.Equal(t, "", c.Locale)
+	assert.Equal(t, []string{"a.b"}, c.Keys())
+
+	_, err = ParseString(RubyYAML, "en: {}\nde: {}\n")
+	assert.NotNil(t, err)
+	_, err = ParseString(RubyYAML, "en: a\n")
+	assert.NotNil(t, err)
+	_, err = ParseString(YAML, "a: [")
+	assert.NotNil(t, err)
+}
+
+func TestYAMLEncode(t *testing.T) {
+	c := NewCatalog("de")
+	c.Add(&Message{Key: "app.title", Value: "Hallo"})
+	c.Add(&Message{Key: "app.items", Plural: map[string]string{One: "%{count} Element", Other: "%{count} Elemente"}})
+
+	var b bytes.Buffer
+	err := Write(RubyYML, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, `de:
+  app:
+    title: Hallo
+    items:
+      one: '%{count} Element'
+      other: '%{count} Elemente'
+`, b.String())
+
+	c.Locale = ""
+	assert.NotNil(t, Write(RubyYML, &b, c))
+
+	b.Reset()
+	err = Write(YAML, &b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, "app:\n  title: Hallo\n  items:\n    one: '%{count} Element'\n    other: '%{count} Elemente'\n", b.String())
+}
@@ -0,0 +1,372 @@
+// Package gen generates Go packages with typed accessors of messages of source catalog
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/validate"
+)
+
+// DefaultPackage is a name of generated package used when Options.Package is empty
+const DefaultPackage = "messages"
+
+// Options is a struct which contains settings of generated package
+type Options struct {
+	// Package is a name of generated package
+	Package string
+	// Source is a name of source file written to header of generated file, e.g. OneSky file name
+	Source string
+}
+
+// reserved are names which can not be used for accessors and parameters of generated code, they are fields
+// and methods of Messages, receiver, imported packages and predeclared identifiers used by generated methods
+var reserved = map[string]bool{
+	"Locale": true, "Lookup": true, "format": true, "plural": true, "m": true,
+	"msgfmt": true, "formats": true, "time": true,
+	"string": true, "int": true, "float64": true, "nil": true,
+}
+
+// param is a single parameter of accessor
+type param struct {
+	ident string
+	// arg is a name of placeholder in msgfmt.Args
+	arg string
+	typ string
+}
+
+// accessor is a single generated function
+type accessor struct {
+	name    string
+	key     string
+	message *formats.Message
+	params  []param
+	args    []param
+}
+
+// Go writes Go package with Messages type which has one method per message of catalog, parameters of methods are
+// derived from placeholders of source texts and locale of catalog is used as source locale. Generated code depends
+// on msgfmt package and uses source text when translation is missing.
+func Go(w io.Writer, c *formats.Catalog, opts Options) error {
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = DefaultPackage
+	}
+	if !token.IsIdentifier(pkg) {
+		return fmt.Errorf("invalid package name %q", pkg)
+	}
+
+	var accessors []accessor
+	names := map[string]bool{}
+	plural, usesTime := false, false
+	for _, m := range c.Messages {
+		if m.Obsolete {
+			continue
+		}
+		a := accessor{name: unique(exported(m.Context+" "+m.Key), names), key: m.Key, message: m}
+		if m.Context != "" {
+			a.key = m.Context + "|" + m.Key
+		}
+		a.params, a.args = params(m)
+		for _, p := range a.params {
+			usesTime = usesTime || p.typ == "time.Time"
+		}
+		plural = plural || m.IsPlural()
+		accessors = append(accessors, a)
+	}
+
+	var b bytes.Buffer
+	if opts.Source != "" {
+		fmt.Fprintf(&b, "// Code generated by onesky gen go from %s. DO NOT EDIT.\n\n", opts.Source)
+	} else {
+		fmt.Fprintf(&b, "// Code generated by onesky gen go. DO NOT EDIT.\n\n")
+	}
+	fmt.Fprintf(&b, "// Package %s contains typed accessors of translations\npackage %s\n\nimport (\n", pkg, pkg)
+	if usesTime {
+		fmt.Fprintf(&b, "\t\"time\"\n\n")
+	}
+	if plural {
+		fmt.Fprintf(&b, "\t\"github.com/SebastianCzoch/onesky-go/formats\"\n")
+	}
+	fmt.Fprintf(&b, "\t\"github.com/SebastianCzoch/onesky-go/msgfmt\"\n)\n\n")
+	fmt.Fprintf(&b, "%s\nconst SourceLocale = %q\n\n", "// SourceLocale is a locale of source texts used when translation is missing", c.Locale)
+	b.WriteString(header)
+	if plural {
+		b.WriteString(pluralHelper)
+	}
+
+	for _, a := range accessors {
+		writeAccessor(&b, a)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %s", err)
+	}
+	_, err = w.Write(src)
+
+	return err
+}
+
+const header = `// Lookup returns translation of key in plural category, category is empty for messages without plural forms.
+// Key of message with context is "context|key". Empty string means that translation is missing.
+type Lookup func(key, category string) string
+
+// Messages is a set of typed accessors of messages in locale
+type Messages struct {
+	Locale string
+	Lookup Lookup
+}
+
+// New returns accessors of messages in locale
+func New(locale string, lookup Lookup) Messages {
+	return Messages{Locale: locale, Lookup: lookup}
+}
+
+func (m Messages) format(key, source string, args msgfmt.Args) string {
+	if m.Lookup != nil {
+		if text := m.Lookup(key, ""); text != "" {
+			return msgfmt.Format(m.Locale, text, args)
+		}
+	}
+
+	return msgfmt.Format(SourceLocale, source, args)
+}
+`
+
+const pluralHelper = `
+func (m Messages) plural(key string, count int, sources map[string]string, args msgfmt.Args) string {
+	if m.Lookup != nil {
+		if text := m.Lookup(key, formats.PluralCategory(m.Locale, count)); text != "" {
+			return msgfmt.Format(m.Locale, text, args)
+		}
+	}
+	source, ok := sources[formats.PluralCategory(SourceLocale, count)]
+	if !ok {
+		source = sources[formats.Other]
+	}
+
+	return msgfmt.Format(SourceLocale, source, args)
+}
+`
+
+func writeAccessor(b *bytes.Buffer, a accessor) {
+	m := a.message
+	var params, args []string
+	for _, p := range a.params {
+		params = append(params, p.ident+" "+p.typ)
+	}
+	for _, p := range a.args {
+		args = append(args, fmt.Sprintf("%q: %s", p.arg, p.ident))
+	}
+	argsLiteral := "nil"
+	if len(args) > 0 {
+		argsLiteral = "msgfmt.Args{" + strings.Join(args, ", ") + "}"
+	}
+
+	comments := func() {
+		for _, c := range m.ExtractedComments {
+			fmt.Fprintf(b, "//\n// %s\n", strings.Replace(c, "\n", " ", -1))
+		}
+	}
+
+	fmt.Fprintln(b)
+	if !m.IsPlural() {
+		fmt.Fprintf(b, "// %s returns message %q: %s\n", a.name, a.key, summary(text(m)))
+		comments()
+		fmt.Fprintf(b, "func (m Messages) %s(%s) string {\n", a.name, strings.Join(params, ", "))
+		fmt.Fprintf(b, "\treturn m.format(%q, %q, %s)\n}\n", a.key, text(m), argsLiteral)
+		return
+	}
+
+	forms := pluralForms(m)
+	var sources []string
+	for _, category := range formats.PluralCategories {
+		if v, ok := forms[category]; ok {
+			sources = append(sources, fmt.Sprintf("%q: %q", category, v))
+		}
+	}
+	fmt.Fprintf(b, "// %s returns plural message %q: %s\n", a.name, a.key, summary(forms[formats.Other]))
+	comments()
+	fmt.Fprintf(b, "func (m Messages) %s(%s) string {\n", a.name, strings.Join(params, ", "))
+	fmt.Fprintf(b, "\treturn m.plural(%q, count, map[string]string{%s}, %s)\n}\n", a.key, strings.Join(sources, ", "), argsLiteral)
+}
+
+// text returns source text of message, source of bilingual message is used when value is empty
+func text(m *formats.Message) string {
+	if m.Value == "" && m.Source != "" {
+		return m.Source
+	}
+
+	return m.Value
+}
+
+// pluralForms returns source plural forms of message, source forms of bilingual message are used when forms are empty
+func pluralForms(m *formats.Message) map[string]string {
+	if len(m.Plural) == 0 {
+		return m.SourcePlural
+	}
+
+	return m.Plural
+}
+
+// summary returns quoted first line of text shortened for doc comment
+func summary(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i] + "..."
+	}
+	if r := []rune(s); len(r) > 60 {
+		s = string(r[:60]) + "..."
+	}
+
+	return strconv.Quote(s)
+}
+
+// params returns parameters of accessor of message and arguments of msgfmt.Args which they are bound to.
+// Plural messages have count int parameter which is bound to placeholder named count and to first printf
+// placeholder when it is an integer.
+func params(m *formats.Message) ([]param, []param) {
+	var texts []string
+	if m.IsPlural() {
+		forms := pluralForms(m)
+		for _, category := range formats.PluralCategories {
+			if v, ok := forms[category]; ok {
+				texts = append(texts, v)
+			}
+		}
+	} else {
+		texts = []string{text(m)}
+	}
+
+	var list, args []param
+	seen := map[string]bool{}
+	idents := map[string]bool{}
+	if m.IsPlural() {
+		count := param{ident: "count", arg: "count", typ: "int"}
+		idents[count.ident], seen[count.arg] = true, true
+		list, args = append(list, count), append(args, count)
+	}
+	for _, t := range texts {
+		sequential := 0
+		for _, p := range validate.Placeholders(t) {
+			arg := p.Name
+			if p.Kind == validate.PrintfPlaceholder && arg == "" {
+				sequential++
+				arg = strconv.Itoa(sequential)
+			}
+			if seen[arg] {
+				continue
+			}
+			seen[arg] = true
+
+			typ := goType(p)
+			if m.IsPlural() && arg == "1" && typ == "int" {
+				args = append(args, param{ident: "count", arg: arg, typ: typ})
+				continue
+			}
+			ident := arg
+			if p.Kind == validate.PrintfPlaceholder {
+				ident = "arg" + arg
+			}
+			p := param{ident: unique(unexported(ident), idents), arg: arg, typ: typ}
+			list, args = append(list, p), append(args, p)
+		}
+	}
+
+	return list, args
+}
+
+// goType returns Go type of placeholder argument
+func goType(p validate.Placeholder) string {
+	switch p.Kind {
+	case validate.PrintfPlaceholder:
+		switch p.Type {
+		case "d", "o", "u", "x", "c":
+			return "int"
+		case "f", "e", "g", "a":
+			return "float64"
+		case "s":
+			return "string"
+		}
+		return "interface{}"
+	case validate.NamedPlaceholder:
+		switch p.Type {
+		case "", "select":
+			return "string"
+		case "plural", "selectordinal":
+			return "int"
+		case "number":
+			return "float64"
+		case "date", "time":
+			return "time.Time"
+		}
+		return "interface{}"
+	}
+
+	return "string"
+}
+
+// words splits name into words of letters and digits
+func words(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// exported returns exported Go identifier of name, e.g. CheckoutTitle for checkout.title
+func exported(name string) string {
+	var b strings.Builder
+	for _, w := range words(name) {
+		r := []rune(w)
+		b.WriteString(string(unicode.ToUpper(r[0])) + string(r[1:]))
+	}
+	s := b.String()
+	if s == "" || !unicode.IsLetter([]rune(s)[0]) {
+		s = "M" + s
+	}
+
+	return s
+}
+
+// unexported returns unexported Go identifier of name, e.g. userName for user.name
+func unexported(name string) string {
+	var b strings.Builder
+	for i, w := range words(name) {
+		r := []rune(w)
+		if i == 0 {
+			r[0] = unicode.ToLower(r[0])
+		} else {
+			r[0] = unicode.ToUpper(r[0])
+		}
+		b.WriteString(string(r))
+	}
+	s := b.String()
+	if s == "" || !unicode.IsLetter([]rune(s)[0]) {
+		s = "arg" + s
+	}
+	if token.IsKeyword(s) {
+		s += "Arg"
+	}
+
+	return s
+}
+
+// unique returns name which is not in used names and not reserved, numeric suffix is added when needed
+func unique(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate] || reserved[candidate]; i++ {
+		candidate = name + strconv.Itoa(i)
+	}
+	used[candidate] = true
+
+	return candidate
+}
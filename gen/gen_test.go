@@ -0,0 +1,118 @@
+package gen
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCatalog = `{
+  "checkout": {"title": "Checkout", "total": "Total: %.2f %s"},
+  "greeting": "Hello {{user.name}}, you have {count, plural, one {# message} other {# messages}}",
+  "since": "Member since {date, date}",
+  "type": "Type {type}",
+  "files": {"one": "%d file in {folder}", "other": "%d files in {folder}"},
+  "404": "Not found"
+}`
+
+func TestGo(t *testing.T) {
+	c, err := formats.ParseString(formats.HierarchicalJSON, testCatalog)
+	assert.Nil(t, err)
+	c.Locale = "en"
+
+	var b bytes.Buffer
+	assert.Nil(t, Go(&b, c, Options{Package: "i18n", Source: "en.json"}))
+	src := b.String()
+
+	assert.Contains(t, src, "// Code generated by onesky gen go from en.json. DO NOT EDIT.\n\n// Package i18n contains typed accessors of translations\npackage i18n\n")
+	assert.Contains(t, src, "import (\n\t\"time\"\n\n\t\"github.com/SebastianCzoch/onesky-go/formats\"\n\t\"github.com/SebastianCzoch/onesky-go/msgfmt\"\n)\n")
+	assert.Contains(t, src, "const SourceLocale = \"en\"\n")
+	assert.Contains(t, src, `// CheckoutTitle returns message "checkout.title": "Checkout"
+func (m Messages) CheckoutTitle() string {
+	return m.format("checkout.title", "Checkout", nil)
+}`)
+	assert.Contains(t, src, `func (m Messages) CheckoutTotal(arg1 float64, arg2 string) string {
+	return m.format("checkout.total", "Total: %.2f %s", msgfmt.Args{"1": arg1, "2": arg2})
+}`)
+	assert.Contains(t, src, `func (m Messages) Greeting(userName string, count int) string {`)
+	assert.Contains(t, src, `msgfmt.Args{"user.name": userName, "count": count})`)
+	assert.Contains(t, src, `func (m Messages) Since(date time.Time) string {`)
+	assert.Contains(t, src, `func (m Messages) Type(typeArg string) string {`)
+	assert.Contains(t, src, `// Files returns plural message "files": "%d files in {folder}"
+func (m Messages) Files(count int, folder string) string {
+	return m.plural("files", count, map[string]string{"one": "%d file in {folder}", "other": "%d files in {folder}"}, msgfmt.Args{"count": count, "1": count, "folder": folder})
+}`)
+	assert.Contains(t, src, `func (m Messages) M404() string {`)
+
+	assert.NotNil(t, Go(&b, c, Options{Package: "a-b"}))
+}
+
+func TestGoWithoutPlurals(t *testing.T) {
+	c, err := formats.ParseString(formats.GNUPO, `msgctxt "menu"
+msgid "Open"
+msgstr "Open"
+
+#. Button which opens file
+msgid "Open"
+msgstr "Open"
+
+msgid "locale"
+msgstr "Locale"
+`)
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	assert.Nil(t, Go(&b, c, Options{}))
+	src := b.String()
+	assert.Contains(t, src, "package messages\n\nimport (\n\t\"github.com/SebastianCzoch/onesky-go/msgfmt\"\n)\n")
+	assert.Contains(t, src, "const SourceLocale = \"\"\n")
+	assert.Contains(t, src, `func (m Messages) MenuOpen() string {
+	return m.format("menu|Open", "Open", nil)
+}`)
+	assert.Contains(t, src, `// Open returns message "Open": "Open"
+//
+// Button which opens file
+func (m Messages) Open() string {`)
+	assert.Contains(t, src, `func (m Messages) Locale2() string {`)
+	assert.NotContains(t, src, "func (m Messages) plural")
+}
+
+func TestIdentifiers(t *testing.T) {
+	assert.Equal(t, "CheckoutTitle", exported("checkout.title"))
+	assert.Equal(t, "MenuSaveAs", exported("menu save_as"))
+	assert.Equal(t, "M1stPlace", exported("1st-place"))
+	assert.Equal(t, "userName", unexported("user.name"))
+	assert.Equal(t, "arg0", unexported("0"))
+	assert.Equal(t, "funcArg", unexported("func"))
+
+	used := map[string]bool{}
+	assert.Equal(t, "Title", unique("Title", used))
+	assert.Equal(t, "Title2", unique("Title", used))
+	assert.Equal(t, "Lookup2", unique("Lookup", used))
+}
+
+func TestGoCompiles(t *testing.T) {
+	c, err := formats.ParseString(formats.HierarchicalJSON, `{
+  "a": "{msgfmt} {formats} {time} {m} {nil}",
+  "b": {"one": "%d {string} {int} {float64}", "other": "%d {string} {int} {float64}"},
+  "c": "{when, date} {format} {plural}"
+}`)
+	assert.Nil(t, err)
+	c.Locale = "en"
+
+	var b bytes.Buffer
+	assert.Nil(t, Go(&b, c, Options{}))
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "messages.go", b.Bytes(), 0)
+	assert.Nil(t, err)
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("messages", fset, []*ast.File{f}, nil)
+	assert.Nil(t, err, b.String())
+}
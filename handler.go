@@ -0,0 +1,488 @@
+package onesky
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// AutoLocale is a locale of path which is negotiated from Accept-Language header by Handler
+const AutoLocale = "auto"
+
+// DefaultHandlerTTL is a time for which Handler caches downloaded files when HandlerOptions.TTL is not set
+const DefaultHandlerTTL = 5 * time.Minute
+
+// DefaultCacheControl is a Cache-Control header of Handler responses, browsers revalidate translations with ETag
+const DefaultCacheControl = "no-cache"
+
+// HandlerOptions is a struct which contains options of Handler
+type HandlerOptions struct {
+	// Namespaces maps namespace of path to OneSky file name, e.g. "common" to "common.json"
+	Namespaces map[string]string
+	// DefaultNamespace is a namespace served by /{locale}.json
+	DefaultNamespace string
+	// Locales are served locales, all locales from GetLanguages are served when empty
+	Locales []string
+	// DefaultLocale is a locale served when Accept-Language does not match any locale, first locale is used when empty
+	DefaultLocale string
+	// TTL is a time for which downloaded files and languages are cached
+	TTL time.Duration
+	// CacheControl is a Cache-Control header of responses
+	CacheControl string
+	// DisableGzip disables compression of responses
+	DisableGzip bool
+	// CORS allows cross-origin requests, they are not allowed when AllowedOrigins is empty
+	CORS CORSOptions
+	// OnError is called with errors of OneSky API, responses contain only generic message because errors
+	// may contain request URL with credentials
+	OnError func(err error)
+}
+
+// CORSOptions is a struct which contains settings of cross-origin requests of Handler
+type CORSOptions struct {
+	// AllowedOrigins are origins allowed to fetch translations, "*" allows any origin
+	AllowedOrigins []string
+	// MaxAge is a time for which browsers cache results of preflight requests
+	MaxAge time.Duration
+}
+
+// Handler returns http.Handler which serves translations downloaded with DownloadFile to frontends. Paths are
+// /{locale}.json for default namespace and /{locale}/{namespace}.json, handler is usually mounted with
+// http.StripPrefix. Locale "auto" is negotiated from Accept-Language header, other locales are matched against
+// served locales, e.g. de-AT is served as de. Files are cached for TTL, stale file is served when download fails.
+func (c *Client) Handler(opts HandlerOptions) http.Handler {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultHandlerTTL
+	}
+	if opts.CacheControl == "" {
+		opts.CacheControl = DefaultCacheControl
+	}
+
+	return &handler{client: c, opts: opts, files: map[string]*cachedFile{}, downloads: map[string]*download{}}
+}
+
+type handler struct {
+	client *Client
+	opts   HandlerOptions
+
+	mu        sync.Mutex
+	files     map[string]*cachedFile
+	downloads map[string]*download
+	languages *languagesFetch
+	locales   []string
+	matcher   language.Matcher
+	localesAt time.Time
+}
+
+// cachedFile is a downloaded file with precomputed ETags and compressed content, representations have
+// different ETags
+type cachedFile struct {
+	content  []byte
+	gzipped  []byte
+	etag     string
+	gzipETag string
+	fetched  time.Time
+}
+
+// download is a download of file in progress, concurrent requests of the same file wait for it. Canceled is
+// true when download failed because request which started it was canceled, waiting requests retry then
+type download struct {
+	done     chan struct{}
+	file     *cachedFile
+	err      error
+	canceled bool
+}
+
+// languagesFetch is a fetch of project languages in progress, concurrent requests wait for it
+type languagesFetch struct {
+	done     chan struct{}
+	err      error
+	canceled bool
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.cors(w, r) {
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD, OPTIONS")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	locale, namespace, ok := parseHandlerPath(r.URL.Path, h.opts.DefaultNamespace)
+	fileName, known := h.opts.Namespaces[namespace]
+	if !ok || !known {
+		http.NotFound(w, r)
+		return
+	}
+
+	matcher, locales, err := h.supported(r.Context())
+	if err != nil {
+		h.unavailable(w, err)
+		return
+	}
+	if len(locales) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	if locale == AutoLocale {
+		w.Header().Add("Vary", "Accept-Language")
+		locale = negotiate(matcher, locales, r.Header.Get("Accept-Language"))
+	} else {
+		locale = matchLocale(matcher, locales, locale)
+	}
+	if locale == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	f, err := h.file(r.Context(), fileName, locale)
+	if err != nil {
+		h.unavailable(w, err)
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", contentType(fileName))
+	header.Set("Content-Language", locale)
+	header.Set("Cache-Control", h.opts.CacheControl)
+	header.Set("Last-Modified", f.fetched.Format(http.TimeFormat))
+	body, etag := f.content, f.etag
+	if !h.opts.DisableGzip {
+		header.Add("Vary", "Accept-Encoding")
+		if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+			header.Set("Content-Encoding", "gzip")
+			body, etag = f.gzipped, f.gzipETag
+		}
+	}
+	header.Set("ETag", etag)
+	if etagMatches(r.Header.Get("If-None-Match"), f.etag, f.gzipETag) {
+		header.Del("Content-Encoding")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	if r.Method == http.MethodHead {
+		return
+	}
+	w.Write(body)
+}
+
+// unavailable reports error and responds with generic message, error is not sent to client
+func (h *handler) unavailable(w http.ResponseWriter, err error) {
+	h.report(err)
+	http.Error(w, "translations unavailable", http.StatusBadGateway)
+}
+
+func (h *handler) report(err error) {
+	if h.opts.OnError != nil {
+		h.opts.OnError(err)
+	}
+}
+
+// cors sets CORS headers of allowed origin, false is returned when request was answered as preflight
+func (h *handler) cors(w http.ResponseWriter, r *http.Request) bool {
+	allowed := h.allowedOrigin(r.Header.Get("Origin"))
+	header := w.Header()
+	if allowed != "" {
+		header.Set("Access-Control-Allow-Origin", allowed)
+		if allowed != "*" {
+			header.Add("Vary", "Origin")
+		}
+		header.Set("Access-Control-Expose-Headers", "ETag, Content-Language")
+	}
+	if r.Method != http.MethodOptions {
+		return true
+	}
+
+	header.Set("Allow", "GET, HEAD, OPTIONS")
+	if allowed != "" {
+		header.Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+		header.Set("Access-Control-Allow-Headers", "Accept-Language, If-None-Match")
+		if h.opts.CORS.MaxAge > 0 {
+			header.Set("Access-Control-Max-Age", strconv.Itoa(int(h.opts.CORS.MaxAge/time.Second)))
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+
+	return false
+}
+
+// allowedOrigin returns value of Access-Control-Allow-Origin header for origin, it is empty when origin is not allowed
+func (h *handler) allowedOrigin(origin string) string {
+	for _, o := range h.opts.CORS.AllowedOrigins {
+		if o == "*" {
+			return "*"
+		}
+		if origin != "" && o == origin {
+			return origin
+		}
+	}
+
+	return ""
+}
+
+// supported returns served locales and their matcher, languages of project are cached for TTL and concurrent
+// requests share one fetch of them
+func (h *handler) supported(ctx context.Context) (language.Matcher, []string, error) {
+	for {
+		h.mu.Lock()
+		if h.matcher != nil && (len(h.opts.Locales) > 0 || time.Since(h.localesAt) < h.opts.TTL) {
+			matcher, locales := h.matcher, h.locales
+			h.mu.Unlock()
+			return matcher, locales, nil
+		}
+		f := h.languages
+		if f == nil {
+			f = &languagesFetch{done: make(chan struct{})}
+			h.languages = f
+			h.mu.Unlock()
+			return h.loadLocales(ctx, f)
+		}
+		h.mu.Unlock()
+
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		if f.err != nil && !f.canceled {
+			return nil, nil, f.err
+		}
+	}
+}
+
+// loadLocales builds matcher of served locales, languages of project are fetched without holding lock when
+// locales are not configured
+func (h *handler) loadLocales(ctx context.Context, f *languagesFetch) (language.Matcher, []string, error) {
+	defer close(f.done)
+
+	locales := h.opts.Locales
+	var err error
+	if len(locales) == 0 {
+		var languages []Language
+		languages, err = h.client.getLanguages(ctx)
+		for _, l := range languages {
+			locales = append(locales, l.Code)
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.languages = nil
+	if err != nil {
+		f.canceled = ctx.Err() != nil
+		if h.matcher == nil {
+			f.err = err
+			return nil, nil, err
+		}
+		if !f.canceled {
+			h.report(err)
+			// languages are retried after TTL, locales loaded before are served meanwhile
+			h.localesAt = time.Now()
+		}
+		return h.matcher, h.locales, nil
+	}
+
+	// default locale is the first one, matcher falls back to it
+	ordered := []string{}
+	if h.opts.DefaultLocale != "" {
+		ordered = append(ordered, h.opts.DefaultLocale)
+	}
+	for _, l := range locales {
+		if l != h.opts.DefaultLocale {
+			ordered = append(ordered, l)
+		}
+	}
+	tags := make([]language.Tag, 0, len(ordered))
+	for _, l := range ordered {
+		tags = append(tags, language.Make(strings.Replace(l, "_", "-", -1)))
+	}
+	h.matcher, h.locales, h.localesAt = language.NewMatcher(tags), ordered, time.Now()
+
+	return h.matcher, h.locales, nil
+}
+
+// negotiate returns served locale which matches Accept-Language header best, default locale is returned when
+// nothing matches
+func negotiate(matcher language.Matcher, locales []string, accept string) string {
+	desired, _, err := language.ParseAcceptLanguage(accept)
+	if err != nil || len(desired) == 0 {
+		return locales[0]
+	}
+	_, index, _ := matcher.Match(desired...)
+
+	return locales[index]
+}
+
+// matchLocale returns served locale for locale of path, e.g. de for de-AT, it is empty when nothing matches
+func matchLocale(matcher language.Matcher, locales []string, locale string) string {
+	for _, l := range locales {
+		if strings.EqualFold(l, locale) {
+			return l
+		}
+	}
+
+	tag, err := language.Parse(strings.Replace(locale, "_", "-", -1))
+	if err != nil {
+		return ""
+	}
+	_, index, confidence := matcher.Match(tag)
+	if confidence == language.No {
+		return ""
+	}
+
+	return locales[index]
+}
+
+// file returns cached file or downloads it, concurrent requests of expired file share one download
+func (h *handler) file(ctx context.Context, fileName, locale string) (*cachedFile, error) {
+	key := locale + "/" + fileName
+	for {
+		h.mu.Lock()
+		cached := h.files[key]
+		if cached != nil && time.Since(cached.fetched) < h.opts.TTL {
+			h.mu.Unlock()
+			return cached, nil
+		}
+		d, pending := h.downloads[key]
+		if !pending {
+			d = &download{done: make(chan struct{})}
+			h.downloads[key] = d
+			h.mu.Unlock()
+			return h.download(ctx, key, fileName, locale, cached, d)
+		}
+		h.mu.Unlock()
+
+		select {
+		case <-d.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if d.err == nil || !d.canceled {
+			return d.file, d.err
+		}
+	}
+}
+
+// download downloads file and shares it with requests waiting for d
+func (h *handler) download(ctx context.Context, key, fileName, locale string, cached *cachedFile, d *download) (*cachedFile, error) {
+	d.file, d.err = h.fetch(ctx, fileName, locale, cached)
+	d.canceled = d.err != nil && ctx.Err() != nil
+	h.mu.Lock()
+	delete(h.downloads, key)
+	if d.err == nil {
+		h.files[key] = d.file
+	}
+	h.mu.Unlock()
+	close(d.done)
+
+	return d.file, d.err
+}
+
+// fetch downloads file, stale file is returned when download fails
+func (h *handler) fetch(ctx context.Context, fileName, locale string, stale *cachedFile) (*cachedFile, error) {
+	content, err := h.client.downloadFileContext(ctx, fileName, locale)
+	if err != nil {
+		if stale != nil {
+			if ctx.Err() == nil {
+				h.report(err)
+			}
+			return stale, nil
+		}
+		return nil, err
+	}
+
+	return newCachedFile([]byte(content))
+}
+
+func newCachedFile(content []byte) (*cachedFile, error) {
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	if _, err := zw.Write(content); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	hash := contentHash(content)[:32]
+
+	return &cachedFile{content: content, gzipped: b.Bytes(), etag: `"` + hash + `"`, gzipETag: `"` + hash + `-gz"`, fetched: time.Now()}, nil
+}
+
+// parseHandlerPath returns locale and namespace of /{locale}.json or /{locale}/{namespace}.json path
+func parseHandlerPath(p, defaultNamespace string) (string, string, bool) {
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	if !strings.HasSuffix(p, ".json") {
+		return "", "", false
+	}
+	p = strings.TrimSuffix(p, ".json")
+
+	parts := strings.Split(p, "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return parts[0], defaultNamespace, true
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], true
+	}
+
+	return "", "", false
+}
+
+// contentType returns content type of OneSky file by its extension
+func contentType(fileName string) string {
+	ext := path.Ext(fileName)
+	if ext == ".json" {
+		return "application/json; charset=utf-8"
+	}
+	if t := mime.TypeByExtension(ext); t != "" {
+		return t
+	}
+
+	return "text/plain; charset=utf-8"
+}
+
+// etagMatches returns whether If-None-Match header matches any of ETags, ETags are compared weakly
+func etagMatches(header string, etags ...string) bool {
+	for _, t := range strings.Split(header, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" {
+			return true
+		}
+		for _, etag := range etags {
+			if strings.TrimPrefix(t, "W/") == etag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func acceptsGzip(header string) bool {
+	for _, e := range strings.Split(header, ",") {
+		parts := strings.Split(e, ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+		for _, p := range parts[1:] {
+			if q := strings.Replace(strings.TrimSpace(p), " ", "", -1); q == "q=0" || q == "q=0.0" || q == "q=0.00" || q == "q=0.000" {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,267 @@
+package onesky
+
+import (
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func registerHandlerResponders(downloads *int) {
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en"},{"code":"de"},{"code":"pt-BR"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", func(req *http.Request) (*http.Response, error) {
+		*downloads++
+		q := req.URL.Query()
+		return httpmock.NewStringResponse(200, `{"file": "`+q.Get("source_file_name")+`", "locale": "`+q.Get("locale")+`"}`), nil
+	})
+}
+
+func serve(h http.Handler, method, target string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	downloads := 0
+	registerHandlerResponders(&downloads)
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	h := http.StripPrefix("/i18n", client.Handler(HandlerOptions{
+		Namespaces:       map[string]string{"app": "app.json", "common": "common.json"},
+		DefaultNamespace: "app",
+		DefaultLocale:    "en",
+	}))
+
+	rec := serve(h, "GET", "/i18n/de.json", nil)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, `{"file": "app.json", "locale": "de"}`, rec.Body.String())
+	assert.Equal(t, "application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "de", rec.Header().Get("Content-Language"))
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "", rec.Header().Get("Access-Control-Allow-Origin"))
+	etag := rec.Header().Get("ETag")
+	assert.Equal(t, 34, len(etag))
+
+	rec = serve(h, "GET", "/i18n/de.json", map[string]string{"If-None-Match": etag})
+	assert.Equal(t, 304, rec.Code)
+	assert.Equal(t, "", rec.Body.String())
+	assert.Equal(t, 1, downloads)
+
+	rec = serve(h, "GET", "/i18n/de.json", map[string]string{"Accept-Encoding": "gzip"})
+	assert.Equal(t, 200, rec.Code)
+	gzipETag := rec.Header().Get("ETag")
+	assert.Equal(t, etag[:len(etag)-1]+`-gz"`, gzipETag)
+	rec = serve(h, "GET", "/i18n/de.json", map[string]string{"If-None-Match": gzipETag})
+	assert.Equal(t, 304, rec.Code)
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+
+	rec = serve(h, "GET", "/i18n/de-AT/common.json", map[string]string{"Accept-Encoding": "gzip, deflate"})
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	zr, err := gzip.NewReader(rec.Body)
+	assert.Nil(t, err)
+	body, err := ioutil.ReadAll(zr)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"file": "common.json", "locale": "de"}`, string(body))
+
+	rec = serve(h, "GET", "/i18n/auto.json", map[string]string{"Accept-Language": "fr-CH, pt;q=0.9, en;q=0.5"})
+	assert.Equal(t, "pt-BR", rec.Header().Get("Content-Language"))
+	assert.Equal(t, []string{"Accept-Language", "Accept-Encoding"}, rec.Header()["Vary"])
+	rec = serve(h, "GET", "/i18n/auto.json", map[string]string{"Accept-Language": "ja"})
+	assert.Equal(t, "en", rec.Header().Get("Content-Language"))
+	rec = serve(h, "HEAD", "/i18n/auto.json", nil)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "en", rec.Header().Get("Content-Language"))
+	assert.Equal(t, "", rec.Body.String())
+
+	assert.Equal(t, 404, serve(h, "GET", "/i18n/ja.json", nil).Code)
+	assert.Equal(t, 404, serve(h, "GET", "/i18n/de/missing.json", nil).Code)
+	assert.Equal(t, 404, serve(h, "GET", "/i18n/de", nil).Code)
+	assert.Equal(t, 405, serve(h, "POST", "/i18n/de.json", nil).Code)
+}
+
+func TestHandlerCORS(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	downloads := 0
+	registerHandlerResponders(&downloads)
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	h := client.Handler(HandlerOptions{
+		Namespaces:       map[string]string{"app": "app.json"},
+		DefaultNamespace: "app",
+		Locales:          []string{"en"},
+		DisableGzip:      true,
+		CORS:             CORSOptions{AllowedOrigins: []string{"https://app.example.com"}, MaxAge: time.Hour},
+	})
+
+	rec := serve(h, "OPTIONS", "/en.json", map[string]string{"Origin": "https://app.example.com"})
+	assert.Equal(t, 204, rec.Code)
+	assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "3600", rec.Header().Get("Access-Control-Max-Age"))
+	assert.Equal(t, "GET, HEAD, OPTIONS", rec.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, 0, downloads)
+
+	rec = serve(h, "GET", "/en.json", map[string]string{"Origin": "https://app.example.com", "Accept-Encoding": "gzip"})
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "", rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, []string{"Origin"}, rec.Header()["Vary"])
+
+	rec = serve(h, "GET", "/en.json", map[string]string{"Origin": "https://evil.example.com"})
+	assert.Equal(t, "", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestHandlerServesStaleFile(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	downloads := 0
+	registerHandlerResponders(&downloads)
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	h := client.Handler(HandlerOptions{Namespaces: map[string]string{"app": "app.json"}, DefaultNamespace: "app", Locales: []string{"en"}, TTL: time.Nanosecond})
+
+	assert.Equal(t, 200, serve(h, "GET", "/en.json", nil).Code)
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", httpmock.NewStringResponder(500, ""))
+	rec := serve(h, "GET", "/en.json", nil)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, `{"file": "app.json", "locale": "en"}`, rec.Body.String())
+
+	h = client.Handler(HandlerOptions{Namespaces: map[string]string{"app": "app.json"}, DefaultNamespace: "app", Locales: []string{"en"}})
+	assert.Equal(t, 502, serve(h, "GET", "/en.json", nil).Code)
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	assert.True(t, acceptsGzip("gzip"))
+	assert.True(t, acceptsGzip("br;q=1.0, gzip;q=0.8"))
+	assert.False(t, acceptsGzip("gzip;q=0"))
+	assert.False(t, acceptsGzip("deflate"))
+	assert.False(t, acceptsGzip(""))
+}
+
+func TestHandlerDoesNotLeakErrors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	var errs []error
+	client := Client{APIKey: "SECRETKEY", Secret: "abcdef", ProjectID: 1, BaseURL: server.URL}
+	h := client.Handler(HandlerOptions{Namespaces: map[string]string{"app": "app.json"}, DefaultNamespace: "app", OnError: func(err error) { errs = append(errs, err) }})
+
+	rec := serve(h, "GET", "/en.json", nil)
+	assert.Equal(t, 502, rec.Code)
+	assert.Equal(t, "translations unavailable\n", rec.Body.String())
+	assert.NotContains(t, rec.Body.String(), client.APIKey)
+
+	h = client.Handler(HandlerOptions{Namespaces: map[string]string{"app": "app.json"}, DefaultNamespace: "app", Locales: []string{"en"}, OnError: func(err error) { errs = append(errs, err) }})
+	rec = serve(h, "GET", "/en.json", nil)
+	assert.Equal(t, 502, rec.Code)
+	assert.NotContains(t, rec.Body.String(), client.APIKey)
+	assert.Equal(t, 2, len(errs))
+	assert.Contains(t, errs[1].Error(), "SECRETKEY")
+}
+
+func TestHandlerSharesDownloads(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var downloads int32
+	release := make(chan struct{})
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&downloads, 1)
+		<-release
+		return httpmock.NewStringResponse(200, `{"title": "Title"}`), nil
+	})
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	h := client.Handler(HandlerOptions{Namespaces: map[string]string{"app": "app.json"}, DefaultNamespace: "app", Locales: []string{"en"}})
+
+	var wg sync.WaitGroup
+	codes := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes <- serve(h, "GET", "/en.json", nil).Code
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(codes)
+
+	for code := range codes {
+		assert.Equal(t, 200, code)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&downloads))
+}
+
+func TestHandlerSharesLanguagesAndCancelsRequests(t *testing.T) {
+	var fetches int32
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/languages") {
+			atomic.AddInt32(&fetches, 1)
+			started <- struct{}{}
+			select {
+			case <-release:
+			case <-r.Context().Done():
+				return
+			}
+			w.Write([]byte(`{"meta":{"status":200},"data":[{"code":"en"}]}`))
+			return
+		}
+		w.Write([]byte(`{"title": "Title"}`))
+	}))
+	defer server.Close()
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1, BaseURL: server.URL}
+	h := client.Handler(HandlerOptions{Namespaces: map[string]string{"app": "app.json"}, DefaultNamespace: "app"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan int)
+	go func() {
+		req := httptest.NewRequest("GET", "/en.json", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		canceled <- rec.Code
+	}()
+	<-started
+
+	var wg sync.WaitGroup
+	codes := make(chan int, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes <- serve(h, "GET", "/en.json", nil).Code
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	select {
+	case code := <-canceled:
+		assert.Equal(t, 502, code)
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not interrupted by canceled context")
+	}
+
+	<-started
+	close(release)
+	wg.Wait()
+	close(codes)
+	for code := range codes {
+		assert.Equal(t, 200, code)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
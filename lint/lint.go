@@ -0,0 +1,224 @@
+// Package lint checks quality of translations downloaded from OneSky with configurable rules
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/validate"
+)
+
+// Rules of linter, placeholder rules of validate package are reported under their own names
+const (
+	RuleLength      = "length"
+	RuleWhitespace  = "whitespace"
+	RuleIdentical   = "identical"
+	RuleForbidden   = "forbidden-term"
+	RuleDoubleSpace = "double-space"
+	RulePunctuation = "punctuation"
+)
+
+// minLengthCheck is a minimal length of source text checked by length rule, short strings vary too much
+const minLengthCheck = 10
+
+// Rules is a struct which contains settings of linter rules, nil values inherit defaults
+type Rules struct {
+	// MaxLengthIncrease is a maximal percentage by which translation may be longer than source, nil disables the rule
+	MaxLengthIncrease *int `yaml:"max_length_increase"`
+	// Whitespace checks that leading and trailing whitespace matches source, enabled by default
+	Whitespace *bool `yaml:"whitespace"`
+	// Identical reports translations identical to source, enabled by default
+	Identical *bool `yaml:"identical"`
+	// DoubleSpaces reports double spaces which are not in source, enabled by default
+	DoubleSpaces *bool `yaml:"double_spaces"`
+	// Punctuation checks that ending punctuation matches source, enabled by default
+	Punctuation *bool `yaml:"punctuation"`
+	// Placeholders runs placeholder, ICU, markup and plural checks of validate package, enabled by default
+	Placeholders *bool `yaml:"placeholders"`
+	// ForbiddenTerms are case insensitive terms which must not appear in translations
+	ForbiddenTerms []string `yaml:"forbidden_terms"`
+	// Ignore are keys which are not checked
+	Ignore []string `yaml:"ignore"`
+}
+
+// Config is a struct which contains default rules and their overrides per locale
+type Config struct {
+	Rules   `yaml:",inline"`
+	Locales map[string]Rules `yaml:"locales"`
+}
+
+// For returns rules of locale, forbidden terms and ignored keys of locale are added to defaults
+func (c Config) For(locale string) Rules {
+	r := c.Rules
+	o, ok := c.Locales[locale]
+	if !ok {
+		return r
+	}
+
+	if o.MaxLengthIncrease != nil {
+		r.MaxLengthIncrease = o.MaxLengthIncrease
+	}
+	for _, f := range []struct{ dst, src **bool }{
+		{&r.Whitespace, &o.Whitespace},
+		{&r.Identical, &o.Identical},
+		{&r.DoubleSpaces, &o.DoubleSpaces},
+		{&r.Punctuation, &o.Punctuation},
+		{&r.Placeholders, &o.Placeholders},
+	} {
+		if *f.src != nil {
+			*f.dst = *f.src
+		}
+	}
+	r.ForbiddenTerms = append(append([]string{}, r.ForbiddenTerms...), o.ForbiddenTerms...)
+	r.Ignore = append(append([]string{}, r.Ignore...), o.Ignore...)
+
+	return r
+}
+
+// Lint checks translations of catalog against source catalog with rules of translation locale.
+// Messages which do not exist in source and empty translations are skipped.
+func (c Config) Lint(source, translation *formats.Catalog) []validate.Violation {
+	if source.Locale != "" && source.Locale == translation.Locale {
+		return nil
+	}
+
+	rules := c.For(translation.Locale)
+	ignored := map[string]bool{}
+	for _, key := range rules.Ignore {
+		ignored[key] = true
+	}
+
+	var violations []validate.Violation
+	for _, m := range translation.Messages {
+		s, ok := source.Lookup(m.Context, m.Key)
+		if !ok || ignored[m.Key] {
+			continue
+		}
+
+		if enabled(rules.Placeholders) {
+			violations = append(violations, validate.Message(translation.Locale, s, m)...)
+		}
+		add := func(category, rule, message string) {
+			violations = append(violations, validate.Violation{Key: m.Key, Context: m.Context, Category: category, Rule: rule, Message: message})
+		}
+		if !m.IsPlural() {
+			if m.Value != "" {
+				for _, p := range rules.check(sourceText(s, formats.Other), m.Value) {
+					add("", p.rule, p.message)
+				}
+			}
+			continue
+		}
+		for _, category := range formats.PluralCategories {
+			if v := m.Plural[category]; v != "" {
+				for _, p := range rules.check(sourceText(s, category), v) {
+					add(category, p.rule, p.message)
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+// problem is a single problem of translated string found by rule
+type problem struct {
+	rule    string
+	message string
+}
+
+// check returns problems of translated string
+func (r Rules) check(source, target string) []problem {
+	var problems []problem
+	add := func(rule, format string, args ...interface{}) {
+		problems = append(problems, problem{rule: rule, message: fmt.Sprintf(format, args...)})
+	}
+
+	if r.MaxLengthIncrease != nil {
+		s, t := utf8.RuneCountInString(source), utf8.RuneCountInString(target)
+		if s >= minLengthCheck && (t-s)*100 > s*(*r.MaxLengthIncrease) {
+			add(RuleLength, "translation is %d%% longer than source, limit is %d%%", (t-s)*100/s, *r.MaxLengthIncrease)
+		}
+	}
+	if enabled(r.Whitespace) {
+		if leading(source) != leading(target) {
+			add(RuleWhitespace, "leading whitespace %q differs from source %q", leading(target), leading(source))
+		}
+		if trailing(source) != trailing(target) {
+			add(RuleWhitespace, "trailing whitespace %q differs from source %q", trailing(target), trailing(source))
+		}
+	}
+	if enabled(r.Identical) && source == target && strings.IndexFunc(source, unicode.IsLetter) >= 0 {
+		add(RuleIdentical, "translation is identical to source")
+	}
+	if enabled(r.DoubleSpaces) && strings.Contains(target, "  ") && !strings.Contains(source, "  ") {
+		add(RuleDoubleSpace, "translation contains double space")
+	}
+	if enabled(r.Punctuation) {
+		if s, t := endPunctuation(source), endPunctuation(target); s != t {
+			add(RulePunctuation, "translation ends with %q, source ends with %q", t, s)
+		}
+	}
+	lower := strings.ToLower(target)
+	for _, term := range r.ForbiddenTerms {
+		if term != "" && strings.Contains(lower, strings.ToLower(term)) {
+			add(RuleForbidden, "translation contains forbidden term %q", term)
+		}
+	}
+
+	return problems
+}
+
+func enabled(b *bool) bool {
+	return b == nil || *b
+}
+
+// sourceText returns source form of plural category, "other" form is used when category is missing in source
+func sourceText(m *formats.Message, category string) string {
+	if !m.IsPlural() {
+		return m.Value
+	}
+	if v, ok := m.Plural[category]; ok {
+		return v
+	}
+
+	return m.Plural[formats.Other]
+}
+
+func leading(s string) string {
+	return s[:len(s)-len(strings.TrimLeftFunc(s, unicode.IsSpace))]
+}
+
+func trailing(s string) string {
+	return s[len(strings.TrimRightFunc(s, unicode.IsSpace)):]
+}
+
+// punctuationEquivalents maps full width and script specific punctuation to ASCII
+var punctuationEquivalents = map[rune]string{
+	'。': ".", '．': ".", '！': "!", '？': "?", '：': ":", '；': ";", '，': ",",
+	'؟': "?", '،': ",", '؛': ";", '…': "...", '।': ".",
+}
+
+// endPunctuation returns normalized punctuation at the end of string, trailing whitespace is ignored
+func endPunctuation(s string) string {
+	s = strings.TrimRightFunc(s, unicode.IsSpace)
+	var marks []string
+	for len(s) > 0 {
+		r, size := utf8.DecodeLastRuneInString(s)
+		if e, ok := punctuationEquivalents[r]; ok {
+			marks = append([]string{e}, marks...)
+		} else if strings.ContainsRune(".!?:;,", r) {
+			marks = append([]string{string(r)}, marks...)
+		} else {
+			break
+		}
+		s = s[:len(s)-size]
+	}
+
+	return strings.Join(marks, "")
+}
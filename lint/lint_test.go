@@ -0,0 +1,88 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestConfigFor(t *testing.T) {
+	c := Config{}
+	err := yaml.UnmarshalStrict([]byte(`
+max_length_increase: 50
+forbidden_terms: [TODO]
+locales:
+  de:
+    max_length_increase: 80
+    punctuation: false
+    forbidden_terms: [Sie]
+    ignore: [brand]
+`), &c)
+	assert.Nil(t, err)
+
+	r := c.For("fr")
+	assert.Equal(t, 50, *r.MaxLengthIncrease)
+	assert.Nil(t, r.Punctuation)
+	assert.Equal(t, []string{"TODO"}, r.ForbiddenTerms)
+
+	r = c.For("de")
+	assert.Equal(t, 80, *r.MaxLengthIncrease)
+	assert.False(t, *r.Punctuation)
+	assert.Equal(t, []string{"TODO", "Sie"}, r.ForbiddenTerms)
+	assert.Equal(t, []string{"brand"}, r.Ignore)
+	assert.Equal(t, []string{"TODO"}, c.ForbiddenTerms)
+}
+
+func TestLint(t *testing.T) {
+	source := formats.NewCatalog("en")
+	source.Add(&formats.Message{Key: "title", Value: "Settings"})
+	source.Add(&formats.Message{Key: "save", Value: "Save changes?"})
+	source.Add(&formats.Message{Key: "label", Value: "Name: "})
+	source.Add(&formats.Message{Key: "hint", Value: "Enter your name"})
+	source.Add(&formats.Message{Key: "brand", Value: "OneSky"})
+	source.Add(&formats.Message{Key: "items", Plural: map[string]string{formats.One: "%d item", formats.Other: "%d items"}})
+	source.Add(&formats.Message{Key: "greeting", Value: "Hello %s"})
+
+	translation := formats.NewCatalog("de")
+	translation.Add(&formats.Message{Key: "title", Value: "Settings"})
+	translation.Add(&formats.Message{Key: "save", Value: "Änderungen speichern？"})
+	translation.Add(&formats.Message{Key: "label", Value: " Name:"})
+	translation.Add(&formats.Message{Key: "hint", Value: "Geben Sie  bitte hier Ihren vollständigen Namen ein."})
+	translation.Add(&formats.Message{Key: "brand", Value: "OneSky"})
+	translation.Add(&formats.Message{Key: "items", Plural: map[string]string{formats.One: "%d Artikel TODO", formats.Other: "%d Artikel"}})
+	translation.Add(&formats.Message{Key: "greeting", Value: "Hallo %d"})
+	translation.Add(&formats.Message{Key: "unknown", Value: "x"})
+
+	increase := 50
+	c := Config{Rules: Rules{MaxLengthIncrease: &increase, ForbiddenTerms: []string{"todo"}}, Locales: map[string]Rules{"de": Rules{Ignore: []string{"brand"}}}}
+	var list []string
+	for _, v := range c.Lint(source, translation) {
+		list = append(list, v.String())
+	}
+	assert.Equal(t, []string{
+		"title: identical: translation is identical to source",
+		"save: length: translation is 61% longer than source, limit is 50%",
+		`label: whitespace: leading whitespace " " differs from source ""`,
+		`label: whitespace: trailing whitespace "" differs from source " "`,
+		"hint: length: translation is 246% longer than source, limit is 50%",
+		"hint: double-space: translation contains double space",
+		`hint: punctuation: translation ends with ".", source ends with ""`,
+		`items[one]: forbidden-term: translation contains forbidden term "todo"`,
+		"greeting: placeholders: placeholder 1 is %s in source but %d in translation",
+	}, list)
+
+	disabled := false
+	c = Config{Rules: Rules{Placeholders: &disabled, Identical: &disabled, Whitespace: &disabled, DoubleSpaces: &disabled, Punctuation: &disabled}}
+	assert.Nil(t, c.Lint(source, translation))
+	assert.Nil(t, Config{}.Lint(source, source))
+}
+
+func TestEndPunctuation(t *testing.T) {
+	assert.Equal(t, "", endPunctuation("Hello"))
+	assert.Equal(t, "?!", endPunctuation("Really?! "))
+	assert.Equal(t, ".", endPunctuation("完成。"))
+	assert.Equal(t, "?", endPunctuation("هل أنت متأكد؟"))
+	assert.Equal(t, "...", endPunctuation("Loading…"))
+}
@@ -0,0 +1,374 @@
+// Package msgfmt formats translatable strings with printf, {{template}} and ICU MessageFormat placeholders
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package msgfmt
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// Args maps placeholder names to values, printf placeholders are named by their position starting with "1"
+type Args map[string]interface{}
+
+// Format replaces placeholders of pattern with arguments, plural cases are selected with CLDR rules of locale.
+// Placeholders without argument and invalid syntax are kept as they are written.
+func Format(locale, pattern string, args Args) string {
+	p := &parser{src: pattern, quoting: strings.ContainsAny(pattern, "{}")}
+	nodes := p.message(false, false)
+
+	var b strings.Builder
+	format(&b, locale, nodes, args, nil)
+
+	return b.String()
+}
+
+// node is a single part of parsed pattern
+type node struct {
+	// raw is a text of node as it is written in pattern, it is written for text and placeholders without argument
+	raw  string
+	kind int
+	// name is an argument name or position of printf placeholder
+	name string
+	// verb is a Go format of printf placeholder
+	verb   string
+	typ    string
+	style  string
+	offset int
+	cases  []selectCase
+}
+
+// Kinds of nodes
+const (
+	textNode = iota
+	printfNode
+	argumentNode
+	hashNode
+)
+
+// selectCase is a single case of plural or select argument
+type selectCase struct {
+	selector string
+	nodes    []node
+}
+
+var (
+	printfPattern   = regexp.MustCompile(`^%(?:(\d+)\$)?([-+#0']*)(\d+)?(?:\.(\d+))?(?:hh|h|ll|l|L|q|j|z|t)?([diouxXeEfFgGaAcsSp@])`)
+	templatePattern = regexp.MustCompile(`^\{\{\s*([^{}\s]+)\s*\}\}`)
+	simplePattern   = regexp.MustCompile(`^\{\s*([\w.-]+)\s*(?:,\s*(\w+)\s*(?:,\s*([^{}]*?)\s*)?)?\}`)
+	complexPattern  = regexp.MustCompile(`^\{\s*([\w.-]+)\s*,\s*(plural|select|selectordinal)\s*,\s*(?:offset:\s*(\d+)\s*)?`)
+	selectorPattern = regexp.MustCompile(`^\s*(=\d+|[\w-]+)\s*\{`)
+	endPattern      = regexp.MustCompile(`^\s*\}`)
+)
+
+// printfVerbs maps printf conversions to Go verbs
+var printfVerbs = map[string]string{"i": "d", "u": "d", "S": "s", "@": "v", "F": "f", "a": "g", "A": "G"}
+
+// parser is a scanner of patterns
+type parser struct {
+	src string
+	pos int
+	// quoting enables ICU apostrophe quoting, it is used only for patterns with braces
+	quoting bool
+	// position is a position of last sequential printf placeholder
+	position int
+}
+
+// message parses text until end of pattern or closing brace of nested message
+func (p *parser) message(nested, inPlural bool) []node {
+	var nodes []node
+	text := func(s string) {
+		if n := len(nodes); n > 0 && nodes[n-1].kind == textNode {
+			nodes[n-1].raw += s
+			return
+		}
+		nodes = append(nodes, node{raw: s})
+	}
+
+	for p.pos < len(p.src) {
+		rest := p.src[p.pos:]
+		switch rest[0] {
+		case '%':
+			if strings.HasPrefix(rest, "%%") {
+				text("%")
+				p.pos += 2
+				continue
+			}
+			if n, ok := p.printf(); ok {
+				nodes = append(nodes, n)
+				continue
+			}
+		case '{':
+			if n, ok := p.argument(); ok {
+				nodes = append(nodes, n)
+				continue
+			}
+		case '}':
+			if nested {
+				return nodes
+			}
+		case '#':
+			if inPlural {
+				nodes = append(nodes, node{raw: "#", kind: hashNode})
+				p.pos++
+				continue
+			}
+		case '\'':
+			if p.quoting {
+				text(p.quoted(inPlural))
+				continue
+			}
+		}
+		text(rest[:1])
+		p.pos++
+	}
+
+	return nodes
+}
+
+// quoted parses ICU apostrophe quoting and returns literal text, apostrophe which does not start quoting is literal
+func (p *parser) quoted(inPlural bool) string {
+	rest := p.src[p.pos:]
+	if strings.HasPrefix(rest, "''") {
+		p.pos += 2
+		return "'"
+	}
+	if len(rest) < 2 || !(rest[1] == '{' || rest[1] == '}' || rest[1] == '#' && inPlural) {
+		p.pos++
+		return "'"
+	}
+
+	var b strings.Builder
+	i := 1
+	for i < len(rest) {
+		if rest[i] != '\'' {
+			b.WriteByte(rest[i])
+			i++
+			continue
+		}
+		if strings.HasPrefix(rest[i:], "''") {
+			b.WriteByte('\'')
+			i += 2
+			continue
+		}
+		i++
+		break
+	}
+	p.pos += i
+
+	return b.String()
+}
+
+func (p *parser) printf() (node, bool) {
+	match := printfPattern.FindStringSubmatch(p.src[p.pos:])
+	if match == nil {
+		return node{}, false
+	}
+	p.pos += len(match[0])
+
+	n := node{raw: match[0], kind: printfNode, name: match[1]}
+	if n.name == "" {
+		p.position++
+		n.name = strconv.Itoa(p.position)
+	}
+	verb := match[5]
+	if v, ok := printfVerbs[verb]; ok {
+		verb = v
+	}
+	n.verb = "%" + strings.Replace(match[2], "'", "", -1) + match[3]
+	if match[4] != "" {
+		n.verb += "." + match[4]
+	}
+	n.verb += verb
+
+	return n, true
+}
+
+// argument parses {{template}} placeholder or ICU argument, false is returned when it is not valid
+func (p *parser) argument() (node, bool) {
+	rest := p.src[p.pos:]
+	if match := templatePattern.FindStringSubmatch(rest); match != nil {
+		p.pos += len(match[0])
+		return node{raw: match[0], kind: argumentNode, name: match[1]}, true
+	}
+	if match := simplePattern.FindStringSubmatch(rest); match != nil {
+		p.pos += len(match[0])
+		return node{raw: match[0], kind: argumentNode, name: match[1], typ: match[2], style: match[3]}, true
+	}
+
+	match := complexPattern.FindStringSubmatch(rest)
+	if match == nil {
+		return node{}, false
+	}
+	start := p.pos
+	p.pos += len(match[0])
+	n := node{kind: argumentNode, name: match[1], typ: match[2]}
+	n.offset, _ = strconv.Atoi(match[3])
+	for {
+		rest = p.src[p.pos:]
+		if m := endPattern.FindString(rest); m != "" {
+			p.pos += len(m)
+			n.raw = p.src[start:p.pos]
+			return n, true
+		}
+		m := selectorPattern.FindStringSubmatch(rest)
+		if m == nil {
+			break
+		}
+		p.pos += len(m[0])
+		c := selectCase{selector: m[1], nodes: p.message(true, n.typ != "select")}
+		if p.pos == len(p.src) {
+			break
+		}
+		p.pos++
+		n.cases = append(n.cases, c)
+	}
+
+	// invalid argument, it is parsed as text
+	p.pos = start
+	return node{}, false
+}
+
+// format writes nodes with arguments, number is a value of # in plural case
+func format(b *strings.Builder, locale string, nodes []node, args Args, number interface{}) {
+	for _, n := range nodes {
+		switch n.kind {
+		case textNode:
+			b.WriteString(n.raw)
+		case hashNode:
+			if number == nil {
+				b.WriteString(n.raw)
+				continue
+			}
+			fmt.Fprint(b, number)
+		case printfNode:
+			v, ok := args[n.name]
+			if !ok {
+				b.WriteString(n.raw)
+				continue
+			}
+			fmt.Fprintf(b, n.verb, v)
+		case argumentNode:
+			v, ok := args[n.name]
+			if !ok && n.cases == nil {
+				b.WriteString(n.raw)
+				continue
+			}
+			switch n.typ {
+			case "plural", "selectordinal":
+				i, _ := toInt(v)
+				var hash interface{}
+				if ok {
+					hash = i - n.offset
+				}
+				format(b, locale, choose(n, locale, i, ok), args, hash)
+			case "select":
+				format(b, locale, choose(n, locale, v, ok), args, number)
+			default:
+				b.WriteString(formatValue(v, n.typ, n.style))
+			}
+		}
+	}
+}
+
+// choose returns nodes of case of plural or select argument, "other" case is used when value is missing
+func choose(n node, locale string, v interface{}, ok bool) []node {
+	var selectors []string
+	switch {
+	case !ok:
+	case n.typ == "select":
+		selectors = []string{fmt.Sprint(v)}
+	case n.typ == "plural":
+		i := v.(int)
+		selectors = []string{"=" + strconv.Itoa(i), formats.PluralCategory(locale, i-n.offset)}
+	default:
+		// ordinal rules are not known, only exact matches are used
+		selectors = []string{"=" + strconv.Itoa(v.(int))}
+	}
+	selectors = append(selectors, formats.Other)
+
+	for _, s := range selectors {
+		for _, c := range n.cases {
+			if c.selector == s {
+				return c.nodes
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatValue formats value of simple ICU argument
+func formatValue(v interface{}, typ, style string) string {
+	switch typ {
+	case "number":
+		if i, ok := toInt(v); ok && (style == "integer" || style == "") {
+			return strconv.Itoa(i)
+		}
+		if f, ok := toFloat(v); ok {
+			if style == "percent" {
+				return strconv.FormatFloat(f*100, 'f', -1, 64) + "%"
+			}
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	case "date", "time":
+		t, ok := v.(time.Time)
+		if !ok {
+			break
+		}
+		if typ == "time" {
+			return t.Format("15:04")
+		}
+		return t.Format("2006-01-02")
+	}
+
+	return fmt.Sprint(v)
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int8:
+		return int(n), true
+	case int16:
+		return int(n), true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case uint:
+		return int(n), true
+	case uint8:
+		return int(n), true
+	case uint16:
+		return int(n), true
+	case uint32:
+		return int(n), true
+	case uint64:
+		return int(n), true
+	case float32:
+		return int(n), float32(int(n)) == n
+	case float64:
+		return int(n), float64(int(n)) == n
+	}
+
+	return 0, false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	i, ok := toInt(v)
+
+	return float64(i), ok
+}
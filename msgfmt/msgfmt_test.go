@@ -0,0 +1,50 @@
+package msgfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatPrintf(t *testing.T) {
+	assert.Equal(t, "Hello John, you have 3 messages (100%)", Format("en", "Hello %s, you have %d messages (100%%)", Args{"1": "John", "2": 3}))
+	assert.Equal(t, "3 Nachrichten für John", Format("de", "%2$d Nachrichten für %1$@", Args{"1": "John", "2": 3}))
+	assert.Equal(t, "  3.14 ff", Format("en", "%6.2f %lx", Args{"1": 3.14159, "2": 255}))
+	assert.Equal(t, "Hello %s, 100 % sure", Format("en", "Hello %s, 100 % sure", nil))
+}
+
+func TestFormatPlaceholders(t *testing.T) {
+	assert.Equal(t, "Hi John, Ann invited you", Format("en", "Hi {{name}}, {user.name} invited you", Args{"name": "John", "user.name": "Ann"}))
+	assert.Equal(t, "Hi {{name}} {missing}", Format("en", "Hi {{name}} {missing}", nil))
+	assert.Equal(t, "1,5 {", Format("en", "{n, number},{m, number, integer} {", Args{"n": 1, "m": int64(5)}))
+	assert.Equal(t, "50% 2.5", Format("en", "{p, number, percent} {f, number}", Args{"p": 0.5, "f": 2.5}))
+	d := time.Date(2016, 2, 3, 14, 5, 0, 0, time.UTC)
+	assert.Equal(t, "2016-02-03 14:05", Format("en", "{d, date, short} {d, time}", Args{"d": d}))
+}
+
+func TestFormatPlural(t *testing.T) {
+	pattern := "{count, plural, =0 {No files} one {# file} few {# pliki} other {# files}}"
+	assert.Equal(t, "No files", Format("en", pattern, Args{"count": 0}))
+	assert.Equal(t, "1 file", Format("en", pattern, Args{"count": 1}))
+	assert.Equal(t, "5 files", Format("en", pattern, Args{"count": 5}))
+	assert.Equal(t, "3 pliki", Format("pl", pattern, Args{"count": 3}))
+	assert.Equal(t, "# files", Format("en", pattern, nil))
+
+	pattern = "{n, plural, offset:1 =1 {{name}} one {{name} and # other} other {{name} and # others}}"
+	assert.Equal(t, "Ann", Format("en", pattern, Args{"n": 1, "name": "Ann"}))
+	assert.Equal(t, "Ann and 1 other", Format("en", pattern, Args{"n": 2, "name": "Ann"}))
+	assert.Equal(t, "Ann and 4 others", Format("en", pattern, Args{"n": 5, "name": "Ann"}))
+
+	pattern = "{g, select, female {She has {n, plural, one {# cat} other {# cats}}} other {They have # cats}}"
+	assert.Equal(t, "She has 2 cats", Format("en", pattern, Args{"g": "female", "n": 2}))
+	assert.Equal(t, "They have # cats", Format("en", pattern, Args{"g": "male", "n": 2}))
+	assert.Equal(t, "1st", Format("en", "{n, selectordinal, =1 {#st} other {#th}}", Args{"n": 1}))
+	assert.Equal(t, "{n, plural, one {x}", Format("en", "{n, plural, one {x}", Args{"n": 1}))
+}
+
+func TestFormatQuoting(t *testing.T) {
+	assert.Equal(t, "Don't use {name}, it's x", Format("en", "Don't use '{name}', it''s {n}", Args{"n": "x"}))
+	assert.Equal(t, "It''s", Format("en", "It''s", nil))
+	assert.Equal(t, "# of 2", Format("en", "{n, plural, other {'#' of #}}", Args{"n": 2}))
+}
@@ -5,17 +5,20 @@ package onesky
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -30,6 +33,17 @@ type Client struct {
 	Secret    string
 	APIKey    string
 	ProjectID int
+
+	// DryRun makes mutating methods (UploadFile, DeleteFile) only log request which would be sent and return synthetic result
+	DryRun bool
+	// Logger is used for dry run messages, standard logger is used when nil
+	Logger *log.Logger
+	// Cache stores files downloaded by DownloadFile on disk, files are always downloaded when nil
+	Cache *Cache
+	// BaseURL is an address of OneSky API, e.g. of oneskytest.Server, APIAddress is used when empty
+	BaseURL string
+	// HTTPClient sends requests to OneSky API, e.g. with oneskytest.Recorder transport, http.DefaultClient is used when nil
+	HTTPClient *http.Client
 }
 
 type apiEndpoint struct {
@@ -167,6 +181,10 @@ func convertToInt64(in interface{}) (int64, error) {
 
 // ImportTask : Show an import task. Parameters: import_id
 func (c *Client) ImportTask(importID int64) (TaskData, error) {
+	return c.importTask(context.Background(), importID)
+}
+
+func (c *Client) importTask(ctx context.Context, importID int64) (TaskData, error) {
 	endpoint, err := getEndpoint("importTask")
 	if err != nil {
 		return TaskData{}, err
@@ -177,7 +195,7 @@ func (c *Client) ImportTask(importID int64) (TaskData, error) {
 		return TaskData{}, err
 	}
 
-	res, err := makeRequest(endpoint.method, urlStr, nil, "")
+	res, err := c.makeRequest(ctx, endpoint.method, urlStr, nil, "")
 	if err != nil {
 		return TaskData{}, err
 	}
@@ -222,7 +240,7 @@ func (c *Client) ImportTasks(params map[string]interface{}) ([]TaskData, error)
 		return nil, err
 	}
 
-	res, err := makeRequest(endpoint.method, urlStr, nil, "")
+	res, err := c.makeRequest(context.Background(), endpoint.method, urlStr, nil, "")
 	if err != nil {
 		return nil, err
 	}
@@ -253,6 +271,10 @@ func (c *Client) ImportTasks(params map[string]interface{}) ([]TaskData, error)
 
 // ListFiles is method on Client struct which download form OneSky service informations about uploaded files
 func (c *Client) ListFiles(page, perPage int) ([]FileData, error) {
+	return c.listFiles(context.Background(), page, perPage)
+}
+
+func (c *Client) listFiles(ctx context.Context, page, perPage int) ([]FileData, error) {
 	endpoint, err := getEndpoint("listFiles")
 	if err != nil {
 		return nil, err
@@ -266,7 +288,7 @@ func (c *Client) ListFiles(page, perPage int) ([]FileData, error) {
 		return nil, err
 	}
 
-	res, err := makeRequest(endpoint.method, urlStr, nil, "")
+	res, err := c.makeRequest(ctx, endpoint.method, urlStr, nil, "")
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +313,20 @@ func (c *Client) ListFiles(page, perPage int) ([]FileData, error) {
 
 // DownloadFile is method on Client struct which download form OneSky service choosen file as string
 func (c *Client) DownloadFile(fileName, locale string) (string, error) {
+	return c.downloadFileContext(context.Background(), fileName, locale)
+}
+
+func (c *Client) downloadFileContext(ctx context.Context, fileName, locale string) (string, error) {
+	if c.Cache != nil {
+		return c.Cache.download(c.ProjectID, fileName, locale, func() (string, error) {
+			return c.downloadFile(ctx, fileName, locale)
+		})
+	}
+
+	return c.downloadFile(ctx, fileName, locale)
+}
+
+func (c *Client) downloadFile(ctx context.Context, fileName, locale string) (string, error) {
 	endpoint, err := getEndpoint("getFile")
 	if err != nil {
 		return "", err
@@ -304,7 +340,7 @@ func (c *Client) DownloadFile(fileName, locale string) (string, error) {
 		return "", err
 	}
 
-	res, err := makeRequest(endpoint.method, urlStr, nil, "")
+	res, err := c.makeRequest(ctx, endpoint.method, urlStr, nil, "")
 	if err != nil {
 		return "", err
 	}
@@ -323,6 +359,11 @@ func (c *Client) DownloadFile(fileName, locale string) (string, error) {
 
 // UploadFile is method on Client struct which upload file to OneSky service
 func (c *Client) UploadFile(file, fileFormat, locale string, keepStrings bool) (UploadData, error) {
+	return c.uploadFile(context.Background(), file, file, fileFormat, locale, keepStrings)
+}
+
+// uploadFile uploads local file under given name, OneSky names uploaded file by its form file name
+func (c *Client) uploadFile(ctx context.Context, file, name, fileFormat, locale string, keepStrings bool) (UploadData, error) {
 	endpoint, err := getEndpoint("postFile")
 	if err != nil {
 		return UploadData{}, err
@@ -337,15 +378,20 @@ func (c *Client) UploadFile(file, fileFormat, locale string, keepStrings bool) (
 		return UploadData{}, err
 	}
 
+	if c.DryRun {
+		return c.dryRunUpload(endpoint.method, urlStr, file, name, fileFormat, locale)
+	}
+
 	var b bytes.Buffer
 	w := multipart.NewWriter(&b)
 	f, err := os.Open(file)
 	if err != nil {
 		return UploadData{}, err
 	}
+	defer f.Close()
 	defer w.Close()
 
-	fw, err := w.CreateFormFile("file", file)
+	fw, err := w.CreateFormFile("file", name)
 	if err != nil {
 		return UploadData{}, err
 	}
@@ -356,7 +402,7 @@ func (c *Client) UploadFile(file, fileFormat, locale string, keepStrings bool) (
 
 	w.Close()
 
-	res, err := makeRequest(endpoint.method, urlStr, &b, w.FormDataContentType())
+	res, err := c.makeRequest(ctx, endpoint.method, urlStr, &b, w.FormDataContentType())
 	if err != nil {
 		return UploadData{}, err
 	}
@@ -384,6 +430,10 @@ func (c *Client) UploadFile(file, fileFormat, locale string, keepStrings bool) (
 
 // DeleteFile is method on Client struct which remove file from OneSky service
 func (c *Client) DeleteFile(fileName string) error {
+	return c.deleteFile(context.Background(), fileName)
+}
+
+func (c *Client) deleteFile(ctx context.Context, fileName string) error {
 	endpoint, err := getEndpoint("deleteFile")
 	if err != nil {
 		return err
@@ -396,7 +446,12 @@ func (c *Client) DeleteFile(fileName string) error {
 		return err
 	}
 
-	res, err := makeRequest(endpoint.method, urlStr, nil, "")
+	if c.DryRun {
+		c.logDryRun(endpoint.method, urlStr, "")
+		return nil
+	}
+
+	res, err := c.makeRequest(ctx, endpoint.method, urlStr, nil, "")
 	if err != nil {
 		return err
 	}
@@ -423,7 +478,7 @@ func (c *Client) GetTranslationsStatus(fileName, locale string) (TranslationsSta
 		return TranslationsStatus{}, err
 	}
 
-	res, err := makeRequest(endpoint.method, urlStr, nil, "")
+	res, err := c.makeRequest(context.Background(), endpoint.method, urlStr, nil, "")
 	if err != nil {
 		return TranslationsStatus{}, err
 	}
@@ -448,6 +503,10 @@ func (c *Client) GetTranslationsStatus(fileName, locale string) (TranslationsSta
 
 // GetLanguages is method on Client struct which download from OneSky service information about available languages in project
 func (c *Client) GetLanguages() ([]Language, error) {
+	return c.getLanguages(context.Background())
+}
+
+func (c *Client) getLanguages(ctx context.Context) ([]Language, error) {
 	endpoint, err := getEndpoint("getLanguages")
 	if err != nil {
 		return nil, err
@@ -459,7 +518,7 @@ func (c *Client) GetLanguages() ([]Language, error) {
 		return nil, err
 	}
 
-	res, err := makeRequest(endpoint.method, urlStr, nil, "")
+	res, err := c.makeRequest(ctx, endpoint.method, urlStr, nil, "")
 	if err != nil {
 		return nil, err
 	}
@@ -482,17 +541,23 @@ func (c *Client) GetLanguages() ([]Language, error) {
 	return aux.Data, nil
 }
 
-func makeRequest(method, urlStr string, body io.Reader, contentType string) (*http.Response, error) {
+// makeRequest sends request which is canceled with ctx
+func (c *Client) makeRequest(ctx context.Context, method, urlStr string, body io.Reader, contentType string) (*http.Response, error) {
 	req, err := http.NewRequest(method, urlStr, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	if contentType != "" {
 		req.Header.Set("Content-Type", contentType)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -520,7 +585,11 @@ func (c *Client) getAuthHashAndTime() (string, string) {
 func (e *apiEndpoint) full(c *Client, additionalArgs url.Values, extends ...interface{}) (string, error) {
 	extends = append([]interface{}{c.ProjectID}, extends...)
 	urlWithProjectID := fmt.Sprintf(e.path, extends...)
-	address, err := url.Parse(APIAddress + "/" + APIVersion + "/" + urlWithProjectID)
+	base := APIAddress
+	if c.BaseURL != "" {
+		base = strings.TrimSuffix(c.BaseURL, "/")
+	}
+	address, err := url.Parse(base + "/" + APIVersion + "/" + urlWithProjectID)
 	if err != nil {
 		return "", err
 	}
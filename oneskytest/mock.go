@@ -0,0 +1,149 @@
+package oneskytest
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/SebastianCzoch/onesky-go"
+)
+
+// Mock is a struct which implements onesky.API with functions set by test and records all calls. Methods
+// without function return error. It is safe for concurrent use.
+type Mock struct {
+	ListFilesFunc             func(page, perPage int) ([]onesky.FileData, error)
+	DownloadFileFunc          func(fileName, locale string) (string, error)
+	UploadFileFunc            func(file, fileFormat, locale string, keepStrings bool) (onesky.UploadData, error)
+	DeleteFileFunc            func(fileName string) error
+	GetTranslationsStatusFunc func(fileName, locale string) (onesky.TranslationsStatus, error)
+	ImportTaskFunc            func(importID int64) (onesky.TaskData, error)
+	ImportTasksFunc           func(params map[string]interface{}) ([]onesky.TaskData, error)
+	GetLanguagesFunc          func() ([]onesky.Language, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Call is a struct which contains method name and arguments of single call of Mock
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+var _ onesky.API = (*Mock)(nil)
+
+// Calls returns all calls in order in which they were made
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns calls of method in order in which they were made
+func (m *Mock) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// Reset removes recorded calls
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
+
+func (m *Mock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("oneskytest: %s is not mocked", method)
+}
+
+// ListFiles records call and calls ListFilesFunc
+func (m *Mock) ListFiles(page, perPage int) ([]onesky.FileData, error) {
+	m.record("ListFiles", page, perPage)
+	if m.ListFilesFunc == nil {
+		return nil, notMocked("ListFiles")
+	}
+
+	return m.ListFilesFunc(page, perPage)
+}
+
+// DownloadFile records call and calls DownloadFileFunc
+func (m *Mock) DownloadFile(fileName, locale string) (string, error) {
+	m.record("DownloadFile", fileName, locale)
+	if m.DownloadFileFunc == nil {
+		return "", notMocked("DownloadFile")
+	}
+
+	return m.DownloadFileFunc(fileName, locale)
+}
+
+// UploadFile records call and calls UploadFileFunc
+func (m *Mock) UploadFile(file, fileFormat, locale string, keepStrings bool) (onesky.UploadData, error) {
+	m.record("UploadFile", file, fileFormat, locale, keepStrings)
+	if m.UploadFileFunc == nil {
+		return onesky.UploadData{}, notMocked("UploadFile")
+	}
+
+	return m.UploadFileFunc(file, fileFormat, locale, keepStrings)
+}
+
+// DeleteFile records call and calls DeleteFileFunc
+func (m *Mock) DeleteFile(fileName string) error {
+	m.record("DeleteFile", fileName)
+	if m.DeleteFileFunc == nil {
+		return notMocked("DeleteFile")
+	}
+
+	return m.DeleteFileFunc(fileName)
+}
+
+// GetTranslationsStatus records call and calls GetTranslationsStatusFunc
+func (m *Mock) GetTranslationsStatus(fileName, locale string) (onesky.TranslationsStatus, error) {
+	m.record("GetTranslationsStatus", fileName, locale)
+	if m.GetTranslationsStatusFunc == nil {
+		return onesky.TranslationsStatus{}, notMocked("GetTranslationsStatus")
+	}
+
+	return m.GetTranslationsStatusFunc(fileName, locale)
+}
+
+// ImportTask records call and calls ImportTaskFunc
+func (m *Mock) ImportTask(importID int64) (onesky.TaskData, error) {
+	m.record("ImportTask", importID)
+	if m.ImportTaskFunc == nil {
+		return onesky.TaskData{}, notMocked("ImportTask")
+	}
+
+	return m.ImportTaskFunc(importID)
+}
+
+// ImportTasks records call and calls ImportTasksFunc
+func (m *Mock) ImportTasks(params map[string]interface{}) ([]onesky.TaskData, error) {
+	m.record("ImportTasks", params)
+	if m.ImportTasksFunc == nil {
+		return nil, notMocked("ImportTasks")
+	}
+
+	return m.ImportTasksFunc(params)
+}
+
+// GetLanguages records call and calls GetLanguagesFunc
+func (m *Mock) GetLanguages() ([]onesky.Language, error) {
+	m.record("GetLanguages")
+	if m.GetLanguagesFunc == nil {
+		return nil, notMocked("GetLanguages")
+	}
+
+	return m.GetLanguagesFunc()
+}
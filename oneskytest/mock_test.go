@@ -0,0 +1,43 @@
+package oneskytest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMock(t *testing.T) {
+	m := &Mock{
+		DownloadFileFunc: func(fileName, locale string) (string, error) {
+			return fmt.Sprintf("%s %s", fileName, locale), nil
+		},
+		ImportTaskFunc: func(importID int64) (onesky.TaskData, error) {
+			return onesky.TaskData{ID: importID, Status: onesky.ImportCompleted}, nil
+		},
+	}
+	var api onesky.API = m
+
+	content, err := api.DownloadFile("en.json", "de")
+	assert.Nil(t, err)
+	assert.Equal(t, "en.json de", content)
+	task, err := api.ImportTask(3)
+	assert.Nil(t, err)
+	assert.Equal(t, onesky.ImportCompleted, task.Status)
+	_, err = api.GetLanguages()
+	assert.Equal(t, "oneskytest: GetLanguages is not mocked", err.Error())
+	assert.NotNil(t, api.DeleteFile("en.json"))
+
+	assert.Equal(t, []Call{
+		Call{Method: "DownloadFile", Args: []interface{}{"en.json", "de"}},
+		Call{Method: "ImportTask", Args: []interface{}{int64(3)}},
+		Call{Method: "GetLanguages", Args: []interface{}(nil)},
+		Call{Method: "DeleteFile", Args: []interface{}{"en.json"}},
+	}, m.Calls())
+	assert.Equal(t, []Call{Call{Method: "DeleteFile", Args: []interface{}{"en.json"}}}, m.CallsTo("DeleteFile"))
+	assert.Nil(t, m.CallsTo("UploadFile"))
+
+	m.Reset()
+	assert.Equal(t, 0, len(m.Calls()))
+}
@@ -0,0 +1,254 @@
+package oneskytest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+)
+
+// base64Encoding is an encoding of recorded body which is not valid UTF-8
+const base64Encoding = "base64"
+
+// Modes of Recorder
+const (
+	// Replay serves responses from cassette file, requests are not sent
+	Replay Mode = iota
+	// Record sends requests with Transport and records them, cassette is written by Save
+	Record
+)
+
+// Mode is a mode of Recorder
+type Mode int
+
+// Cassette is a struct which contains recorded interactions with OneSky API, it is stored as JSON fixture
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a struct which contains single recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a struct which contains request without api_key, dev_hash and timestamp parameters
+type RecordedRequest struct {
+	Method      string `json:"method"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+	// Encoding is "base64" when body is not valid UTF-8, e.g. UTF-16 file, it is empty for text body
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// RecordedResponse is a struct which contains recorded response
+type RecordedResponse struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body"`
+	// Encoding is "base64" when body is not valid UTF-8, e.g. UTF-16 file, it is empty for text body
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// Recorder is a struct which records requests of onesky.Client into cassette file and replays them. Requests are
+// matched by method and URL without authorization parameters, each recorded interaction is replayed once in
+// order of recording. It is safe for concurrent use.
+type Recorder struct {
+	// Path is a path of cassette file
+	Path string
+	// Mode is a mode of recorder
+	Mode Mode
+	// Transport sends requests in Record mode, http.DefaultTransport is used when nil
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder returns recorder of cassette file, cassette is loaded in Replay mode
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{Path: path, Mode: mode}
+	if mode != Replay {
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+
+	return r, nil
+}
+
+// Client returns HTTP client which sends requests through recorder, it can be set as onesky.Client.HTTPClient
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays request
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, body, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Mode == Replay {
+		return r.replay(req, recorded)
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	out := req.Clone(req.Context())
+	out.Body = ioutil.NopCloser(bytes.NewReader(body))
+	res, err := transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := RecordedResponse{Status: res.StatusCode, ContentType: res.Header.Get("Content-Type")}
+	response.Body, response.Encoding = encodeBody(data)
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{Request: recorded, Response: response})
+	r.mu.Unlock()
+	res.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return res, nil
+}
+
+// Save writes recorded interactions into cassette file, it does nothing in Replay mode
+func (r *Recorder) Save() error {
+	if r.Mode == Replay {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.Path, append(data, '\n'), 0644)
+}
+
+// Unused returns interactions which were not replayed yet
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []Interaction
+	for i, used := range r.used {
+		if !used {
+			list = append(list, r.cassette.Interactions[i])
+		}
+	}
+
+	return list
+}
+
+// replay returns response of first unused interaction which matches request
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || in.Request.Method != recorded.Method || in.Request.URL != recorded.URL {
+			continue
+		}
+		body, err := decodeBody(in.Response.Body, in.Response.Encoding)
+		if err != nil {
+			return nil, fmt.Errorf("oneskytest: response of %s %s: %s", recorded.Method, recorded.URL, err)
+		}
+		r.used[i] = true
+
+		header := http.Header{}
+		if in.Response.ContentType != "" {
+			header.Set("Content-Type", in.Response.ContentType)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("oneskytest: no recorded response for %s %s", recorded.Method, recorded.URL)
+}
+
+// recordRequest returns request with scrubbed URL and its body, body is read and closed but request is not
+// modified, body is sent with clone of request
+func recordRequest(req *http.Request) (RecordedRequest, []byte, error) {
+	var body []byte
+	if req.Body != nil {
+		data, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return RecordedRequest{}, nil, err
+		}
+		body = data
+	}
+
+	recorded := RecordedRequest{Method: req.Method, URL: scrub(req.URL), ContentType: req.Header.Get("Content-Type")}
+	recorded.Body, recorded.Encoding = encodeBody(body)
+
+	return recorded, body, nil
+}
+
+// encodeBody returns body as string and its encoding, body which is not valid UTF-8 is encoded with base64
+func encodeBody(data []byte) (string, string) {
+	if utf8.Valid(data) {
+		return string(data), ""
+	}
+
+	return base64.StdEncoding.EncodeToString(data), base64Encoding
+}
+
+// decodeBody returns body of recorded request or response
+func decodeBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case base64Encoding:
+		return base64.StdEncoding.DecodeString(body)
+	}
+
+	return nil, fmt.Errorf("unknown encoding %s", encoding)
+}
+
+// scrub returns URL without authorization parameters, remaining parameters are sorted
+func scrub(u *url.URL) string {
+	c := *u
+	v := c.Query()
+	for _, p := range authParams {
+		v.Del(p)
+	}
+	c.RawQuery = v.Encode()
+
+	return c.String()
+}
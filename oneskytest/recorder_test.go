@@ -0,0 +1,128 @@
+package oneskytest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	s := NewServer(1, "abcdef", "ghijkl")
+	defer s.Close()
+	assert.Nil(t, s.AddFile("en.json", "HIERARCHICAL_JSON", "en", `{"title": "Title"}`))
+	s.SetTranslation("en.json", "de", `{"title": "Titel"}`)
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	path := filepath.Join(tmpdir, "cassettes", "download.json")
+
+	rec, err := NewRecorder(path, Record)
+	assert.Nil(t, err)
+	client := s.Client()
+	client.HTTPClient = rec.Client()
+	content, err := client.DownloadFile("en.json", "de")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"title": "Titel"}`, content)
+	_, err = client.DownloadFile("en.json", "fr")
+	assert.NotNil(t, err)
+	languages, err := client.GetLanguages()
+	assert.Nil(t, err)
+	assert.Nil(t, rec.Save())
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	for _, secret := range []string{"abcdef", "dev_hash", "timestamp"} {
+		assert.False(t, strings.Contains(string(data), secret), secret)
+	}
+
+	// requests are replayed without server and with different credentials
+	s.Close()
+	rec, err = NewRecorder(path, Replay)
+	assert.Nil(t, err)
+	client = &onesky.Client{APIKey: "other", Secret: "other", ProjectID: 1, BaseURL: s.URL, HTTPClient: rec.Client()}
+	replayed, err := client.GetLanguages()
+	assert.Nil(t, err)
+	assert.Equal(t, languages, replayed)
+	content, err = client.DownloadFile("en.json", "de")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"title": "Titel"}`, content)
+	assert.Equal(t, 1, len(rec.Unused()))
+	_, err = client.DownloadFile("en.json", "fr")
+	assert.Equal(t, "bad status: 404 Not Found", err.Error())
+	assert.Equal(t, 0, len(rec.Unused()))
+
+	_, err = client.DownloadFile("en.json", "de")
+	assert.Contains(t, err.Error(), "oneskytest: no recorded response for GET "+s.URL+"/1/projects/1/translations?locale=de&source_file_name=en.json")
+	assert.Nil(t, rec.Save())
+}
+
+func TestRecorderGoldenCassette(t *testing.T) {
+	rec, err := NewRecorder(filepath.Join("testdata", "languages.json"), Replay)
+	assert.Nil(t, err)
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1, HTTPClient: rec.Client()}
+
+	languages, err := client.GetLanguages()
+	assert.Nil(t, err)
+	assert.Equal(t, []onesky.Language{
+		onesky.Language{Code: "en", EnglishName: "English", LocalName: "English", Locale: "en", TranslationProgress: "100.0"},
+		onesky.Language{Code: "de", EnglishName: "German", LocalName: "Deutsch", Locale: "de", TranslationProgress: "42.5"},
+	}, languages)
+
+	_, err = NewRecorder(filepath.Join("testdata", "missing.json"), Replay)
+	assert.NotNil(t, err)
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRecorderBinaryBody(t *testing.T) {
+	utf16 := []byte{0xff, 0xfe, 'H', 0, 'i', 0}
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	path := filepath.Join(tmpdir, "binary.json")
+
+	rec, err := NewRecorder(path, Record)
+	assert.Nil(t, err)
+	var sent []byte
+	rec.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent, _ = ioutil.ReadAll(req.Body)
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(utf16))}, nil
+	})
+	body := ioutil.NopCloser(bytes.NewReader(utf16))
+	req, err := http.NewRequest("POST", "https://platform.api.onesky.io/1/projects/1/files?api_key=abcdef", body)
+	assert.Nil(t, err)
+	res, err := rec.RoundTrip(req)
+	assert.Nil(t, err)
+	assert.Equal(t, utf16, sent)
+	assert.Equal(t, body, req.Body)
+	data, err := ioutil.ReadAll(res.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, utf16, data)
+	assert.Nil(t, rec.Save())
+
+	cassette, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, strings.Count(string(cassette), `"encoding": "base64"`))
+
+	rec, err = NewRecorder(path, Replay)
+	assert.Nil(t, err)
+	req, err = http.NewRequest("POST", "https://platform.api.onesky.io/1/projects/1/files", bytes.NewReader(utf16))
+	assert.Nil(t, err)
+	res, err = rec.RoundTrip(req)
+	assert.Nil(t, err)
+	data, err = ioutil.ReadAll(res.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, utf16, data)
+}
@@ -0,0 +1,570 @@
+// Package oneskytest provides in-process fake of OneSky API for integration tests of tools built on onesky package
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package oneskytest
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// DefaultImportPolls is a number of ImportTask responses for which import stays in progress when
+// Server.ImportPolls is not set
+const DefaultImportPolls = 1
+
+// timeLayout is a layout of dates returned by OneSky API
+const timeLayout = "2006-01-02T15:04:05-0700"
+
+// authParams are query parameters used for authorization, they are not recorded in requests
+var authParams = []string{"api_key", "timestamp", "dev_hash"}
+
+// Server is a struct which contains state of fake OneSky project served by httptest.Server. Requests are
+// authorized with api_key and dev_hash like in OneSky API. Uploaded files are parsed when their format is
+// supported by formats package, import tasks of files which can not be parsed fail.
+type Server struct {
+	*httptest.Server
+
+	ProjectID int
+	APIKey    string
+	Secret    string
+	// ImportPolls is a number of ImportTask responses for which import stays in progress, imports are completed
+	// immediately when it is zero. It should be set before first request.
+	ImportPolls int
+
+	mu        sync.Mutex
+	languages []onesky.Language
+	files     []*file
+	tasks     []*task
+	requests  []Request
+}
+
+// Request is a struct which contains informations about request received by Server, authorization
+// parameters are not included
+type Request struct {
+	Method string
+	Path   string
+	Params url.Values
+}
+
+type file struct {
+	name         string
+	format       string
+	stringCount  int
+	uploaded     time.Time
+	lastImport   *task
+	translations map[string]string
+}
+
+type task struct {
+	id          int64
+	file        *file
+	format      string
+	locale      string
+	stringCount int
+	status      string
+	polls       int
+	created     time.Time
+}
+
+// NewServer starts fake OneSky API of project, server has to be closed with Close
+func NewServer(projectID int, apiKey, secret string) *Server {
+	s := &Server{ProjectID: projectID, APIKey: apiKey, Secret: secret, ImportPolls: DefaultImportPolls}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+
+	return s
+}
+
+// Client returns client of server project
+func (s *Server) Client() *onesky.Client {
+	return &onesky.Client{APIKey: s.APIKey, Secret: s.Secret, ProjectID: s.ProjectID, BaseURL: s.URL}
+}
+
+// AddLanguage adds language to project, its translation progress is computed from translations of files
+func (s *Server) AddLanguage(l onesky.Language) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.languages {
+		if s.languages[i].Code == l.Code {
+			s.languages[i] = l
+			return
+		}
+	}
+	s.languages = append(s.languages, l)
+}
+
+// AddFile adds file with content in locale to project as if it was uploaded and imported
+func (s *Server) AddFile(name, format, locale, content string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.upload(name, format, locale, content)
+	if err != nil {
+		return err
+	}
+	if t.status == onesky.ImportFailed {
+		return fmt.Errorf("%s can not be parsed as %s", name, format)
+	}
+	t.status = onesky.ImportCompleted
+
+	return nil
+}
+
+// SetTranslation sets content of file downloaded in locale, e.g. translation made by translators
+func (s *Server) SetTranslation(fileName, locale, content string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := s.file(fileName)
+	if f == nil {
+		f = &file{name: fileName, uploaded: time.Now(), translations: map[string]string{}}
+		s.files = append(s.files, f)
+	}
+	f.translations[locale] = content
+	s.addLanguage(locale)
+}
+
+// Translation returns content of file in locale
+func (s *Server) Translation(fileName, locale string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := s.file(fileName)
+	if f == nil {
+		return "", false
+	}
+	content, ok := f.translations[locale]
+
+	return content, ok
+}
+
+// Files returns files of project in order of first upload
+func (s *Server) Files() []onesky.FileData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]onesky.FileData, 0, len(s.files))
+	for _, f := range s.files {
+		list = append(list, f.data())
+	}
+
+	return list
+}
+
+// Tasks returns import tasks of project in order of creation
+func (s *Server) Tasks() []onesky.TaskData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]onesky.TaskData, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		list = append(list, onesky.TaskData{ID: t.id, OriginalID: t.id, File: s.taskFile(t), StringCount: t.stringCount, Status: t.status, CreateddAt: t.created.Format(timeLayout), CreateddAtTimestamp: int(t.created.Unix())})
+	}
+
+	return list
+}
+
+// Requests returns authorized requests received by server
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if status, msg := s.authorize(r.Form); status != http.StatusOK {
+		writeError(w, status, msg)
+		return
+	}
+
+	prefix := fmt.Sprintf("/%s/projects/%d/", onesky.APIVersion, s.ProjectID)
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	params := url.Values{}
+	for k, v := range r.Form {
+		params[k] = v
+	}
+	for _, p := range authParams {
+		params.Del(p)
+	}
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Params: params})
+
+	route := r.Method + " " + strings.TrimPrefix(r.URL.Path, prefix)
+	switch {
+	case route == "GET translations":
+		s.downloadFile(w, r)
+	case route == "GET translations/status":
+		s.translationsStatus(w, r)
+	case route == "GET files":
+		s.listFiles(w, r)
+	case route == "POST files":
+		s.uploadFile(w, r)
+	case route == "DELETE files":
+		s.deleteFile(w, r)
+	case route == "GET languages":
+		s.getLanguages(w)
+	case route == "GET import-tasks":
+		s.importTasks(w, r)
+	case strings.HasPrefix(route, "GET import-tasks/"):
+		s.importTask(w, strings.TrimPrefix(route, "GET import-tasks/"))
+	default:
+		writeError(w, http.StatusNotFound, "endpoint not found")
+	}
+}
+
+// authorize verifies api_key and dev_hash, which is md5 of timestamp and secret
+func (s *Server) authorize(params url.Values) (int, string) {
+	timestamp := params.Get("timestamp")
+	if params.Get("api_key") == "" || timestamp == "" || params.Get("dev_hash") == "" {
+		return http.StatusBadRequest, "api_key, timestamp and dev_hash are required"
+	}
+	if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+		return http.StatusBadRequest, "invalid timestamp"
+	}
+	sum := md5.Sum([]byte(timestamp + s.Secret))
+	if params.Get("api_key") != s.APIKey || params.Get("dev_hash") != hex.EncodeToString(sum[:]) {
+		return http.StatusUnauthorized, "invalid api_key or dev_hash"
+	}
+
+	return http.StatusOK, ""
+}
+
+func (s *Server) downloadFile(w http.ResponseWriter, r *http.Request) {
+	f := s.file(r.Form.Get("source_file_name"))
+	if f == nil {
+		writeError(w, http.StatusNotFound, "file not found")
+		return
+	}
+	content, ok := f.translations[r.Form.Get("locale")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "translation not found")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte(content))
+}
+
+func (s *Server) translationsStatus(w http.ResponseWriter, r *http.Request) {
+	f := s.file(r.Form.Get("file_name"))
+	if f == nil {
+		writeError(w, http.StatusNotFound, "file not found")
+		return
+	}
+	locale := r.Form.Get("locale")
+	translated := f.progress(locale)
+
+	writeData(w, http.StatusOK, map[string]interface{}{
+		"file_name":    f.name,
+		"locale":       s.language(locale),
+		"progress":     fmt.Sprintf("%d%%", int(translated)),
+		"string_count": f.stringCount,
+		"word_count":   0,
+	})
+}
+
+func (s *Server) listFiles(w http.ResponseWriter, r *http.Request) {
+	list := make([]onesky.FileData, 0, len(s.files))
+	for _, f := range s.files {
+		list = append(list, f.data())
+	}
+	start, end := page(r.Form, len(list))
+
+	writeData(w, http.StatusOK, list[start:end])
+}
+
+func (s *Server) uploadFile(w http.ResponseWriter, r *http.Request) {
+	upload, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer upload.Close()
+	content, err := ioutil.ReadAll(upload)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	format, locale := r.Form.Get("file_format"), r.Form.Get("locale")
+	if !knownFormat(format) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid file_format %s", format))
+		return
+	}
+	if locale == "" {
+		writeError(w, http.StatusBadRequest, "locale is required")
+		return
+	}
+
+	t, err := s.upload(header.Filename, format, locale, string(content))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeData(w, http.StatusCreated, map[string]interface{}{
+		"name":     t.file.name,
+		"format":   t.format,
+		"language": s.language(locale),
+		"import":   map[string]interface{}{"id": t.id, "created_at": t.created.Format(timeLayout), "created_at_timestamp": t.created.Unix()},
+	})
+}
+
+func (s *Server) deleteFile(w http.ResponseWriter, r *http.Request) {
+	name := r.Form.Get("file_name")
+	for i, f := range s.files {
+		if f.name == name {
+			s.files = append(s.files[:i], s.files[i+1:]...)
+			writeData(w, http.StatusOK, map[string]interface{}{"name": name})
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "file not found")
+}
+
+func (s *Server) getLanguages(w http.ResponseWriter) {
+	list := make([]onesky.Language, 0, len(s.languages))
+	for _, l := range s.languages {
+		total := 0.0
+		for _, f := range s.files {
+			total += f.progress(l.Code)
+		}
+		if len(s.files) > 0 {
+			l.TranslationProgress = strconv.FormatFloat(total/float64(len(s.files)), 'f', 1, 64)
+		}
+		list = append(list, l)
+	}
+
+	writeData(w, http.StatusOK, list)
+}
+
+func (s *Server) importTasks(w http.ResponseWriter, r *http.Request) {
+	status := r.Form.Get("status")
+	list := []interface{}{}
+	for _, t := range s.tasks {
+		if status == "" || status == "all" || status == t.status {
+			list = append(list, s.taskData(t))
+		}
+	}
+	start, end := page(r.Form, len(list))
+
+	writeData(w, http.StatusOK, list[start:end])
+}
+
+// importTask returns import task, import is finished after ImportPolls responses with in-progress status
+func (s *Server) importTask(w http.ResponseWriter, id string) {
+	for _, t := range s.tasks {
+		if strconv.FormatInt(t.id, 10) != id {
+			continue
+		}
+		data := s.taskData(t)
+		if t.status == onesky.ImportInProgress {
+			t.polls++
+			if t.polls > s.ImportPolls {
+				t.status = onesky.ImportCompleted
+				data = s.taskData(t)
+			}
+		}
+		writeData(w, http.StatusOK, data)
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "import task not found")
+}
+
+// upload stores file content in locale and creates its import task, content which can not be parsed fails import
+func (s *Server) upload(name, format, locale, content string) (*task, error) {
+	if name == "" {
+		return nil, fmt.Errorf("file name is required")
+	}
+
+	status, count := onesky.ImportInProgress, 0
+	if _, err := formats.Lookup(formats.Format(format)); err == nil {
+		c, err := formats.ParseString(formats.Format(format), content)
+		if err != nil {
+			status = onesky.ImportFailed
+		} else {
+			count = len(c.Messages)
+		}
+	}
+	if s.ImportPolls <= 0 && status == onesky.ImportInProgress {
+		status = onesky.ImportCompleted
+	}
+
+	f := s.file(name)
+	if f == nil {
+		f = &file{name: name, translations: map[string]string{}}
+		s.files = append(s.files, f)
+	}
+	t := &task{id: int64(len(s.tasks) + 1), file: f, format: format, locale: locale, stringCount: count, status: status, created: time.Now()}
+	s.tasks = append(s.tasks, t)
+	f.lastImport, f.uploaded = t, t.created
+	if status != onesky.ImportFailed {
+		f.format, f.stringCount = format, count
+		f.translations[locale] = content
+		s.addLanguage(locale)
+	}
+
+	return t, nil
+}
+
+func (s *Server) file(name string) *file {
+	for _, f := range s.files {
+		if f.name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) addLanguage(code string) {
+	for _, l := range s.languages {
+		if l.Code == code {
+			return
+		}
+	}
+	s.languages = append(s.languages, language(code))
+}
+
+func (s *Server) language(code string) onesky.Language {
+	for _, l := range s.languages {
+		if l.Code == code {
+			return l
+		}
+	}
+
+	return language(code)
+}
+
+func (s *Server) taskData(t *task) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                   t.id,
+		"file":                 s.taskFile(t),
+		"string_count":         t.stringCount,
+		"word_count":           0,
+		"status":               t.status,
+		"created_at":           t.created.Format(timeLayout),
+		"created_at_timestamp": t.created.Unix(),
+	}
+}
+
+func (s *Server) taskFile(t *task) onesky.TaskFile {
+	return onesky.TaskFile{Name: t.file.name, Format: t.format, Locale: s.language(t.locale)}
+}
+
+func (f *file) data() onesky.FileData {
+	d := onesky.FileData{Name: f.name, FileName: f.name, StringCount: f.stringCount, UpoladedAt: f.uploaded.Format(timeLayout), UpoladedAtTimestamp: int(f.uploaded.Unix())}
+	if f.lastImport != nil {
+		d.LastImport = onesky.LastImport{ID: int(f.lastImport.id), Status: f.lastImport.status}
+	}
+
+	return d
+}
+
+// progress returns percentage of source strings translated in locale, files which can not be parsed are
+// translated when they have content in locale
+func (f *file) progress(locale string) float64 {
+	content, ok := f.translations[locale]
+	if !ok {
+		return 0
+	}
+	if f.stringCount == 0 {
+		return 100
+	}
+	c, err := formats.ParseString(formats.Format(f.format), content)
+	if err != nil {
+		return 100
+	}
+	translated := 0
+	for _, m := range c.Messages {
+		if m.Value != "" || len(m.Plural) > 0 {
+			translated++
+		}
+	}
+	if translated > f.stringCount {
+		translated = f.stringCount
+	}
+
+	return float64(translated) * 100 / float64(f.stringCount)
+}
+
+// language returns language of locale code, e.g. zh-TW is zh in region TW
+func language(code string) onesky.Language {
+	l := onesky.Language{Code: code, Locale: code, EnglishName: code, LocalName: code}
+	if i := strings.IndexAny(code, "-_"); i > 0 {
+		l.Locale, l.Region = code[:i], code[i+1:]
+	}
+
+	return l
+}
+
+func knownFormat(format string) bool {
+	i := sort.SearchStrings(sortedFormats, format)
+
+	return i < len(sortedFormats) && sortedFormats[i] == format
+}
+
+var sortedFormats = func() []string {
+	list := append([]string{}, config.Formats...)
+	for _, f := range formats.Supported() {
+		list = append(list, string(f))
+	}
+	sort.Strings(list)
+
+	return list
+}()
+
+// page returns bounds of page of list selected by page and per_page parameters
+func page(params url.Values, n int) (int, int) {
+	p, err := strconv.Atoi(params.Get("page"))
+	if err != nil || p < 1 {
+		p = 1
+	}
+	perPage, err := strconv.Atoi(params.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = 50
+	}
+
+	start := (p - 1) * perPage
+	if start > n {
+		start = n
+	}
+	end := start + perPage
+	if end > n {
+		end = n
+	}
+
+	return start, end
+}
+
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{"meta": map[string]interface{}{"status": status}, "data": data})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{"meta": map[string]interface{}{"status": status, "message": message}})
+}
@@ -0,0 +1,130 @@
+package oneskytest
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer(t *testing.T) {
+	s := NewServer(7, "key", "secret")
+	defer s.Close()
+	s.AddLanguage(onesky.Language{Code: "en", EnglishName: "English"})
+	assert.Nil(t, s.AddFile("common.json", "HIERARCHICAL_JSON", "en", `{"ok": "OK", "cancel": "Cancel"}`))
+	s.SetTranslation("common.json", "de", `{"ok": "OK", "cancel": ""}`)
+	client := s.Client()
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	path := filepath.Join(tmpdir, "app.json")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"title": "Title"}`), 0644))
+
+	upload, err := client.UploadFile(path, "HIERARCHICAL_JSON", "en", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "app.json", upload.Name)
+	assert.Equal(t, "en", upload.Language.Code)
+	assert.Equal(t, int64(2), upload.Import.ID)
+
+	task, err := client.ImportTask(upload.Import.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, onesky.ImportInProgress, task.Status)
+	tasks, err := client.ImportTasks(map[string]interface{}{"status": onesky.ImportInProgress})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tasks))
+	task, err = client.ImportTask(upload.Import.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, onesky.ImportCompleted, task.Status)
+	assert.Equal(t, "app.json", task.File.Name)
+	assert.Equal(t, 1, task.StringCount)
+
+	files, err := client.ListFiles(1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"common.json", "app.json"}, []string{files[0].Name, files[1].Name})
+	assert.Equal(t, onesky.LastImport{ID: 2, Status: onesky.ImportCompleted}, files[1].LastImport)
+	files, err = client.ListFiles(2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "app.json", files[0].Name)
+
+	content, err := client.DownloadFile("common.json", "de")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ok": "OK", "cancel": ""}`, content)
+	_, err = client.DownloadFile("app.json", "de")
+	assert.NotNil(t, err)
+
+	status, err := client.GetTranslationsStatus("common.json", "de")
+	assert.Nil(t, err)
+	assert.Equal(t, "50%", status.Progress)
+	assert.Equal(t, int64(2), status.StringCount)
+	languages, err := client.GetLanguages()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(languages))
+	assert.Equal(t, "English", languages[0].EnglishName)
+	assert.Equal(t, "100.0", languages[0].TranslationProgress)
+	assert.Equal(t, "25.0", languages[1].TranslationProgress)
+
+	assert.Nil(t, client.DeleteFile("common.json"))
+	assert.NotNil(t, client.DeleteFile("common.json"))
+	assert.Equal(t, 1, len(s.Files()))
+	_, ok := s.Translation("common.json", "de")
+	assert.False(t, ok)
+	translation, ok := s.Translation("app.json", "en")
+	assert.True(t, ok)
+	assert.Equal(t, `{"title": "Title"}`, translation)
+
+	requests := s.Requests()
+	assert.Equal(t, Request{Method: "DELETE", Path: "/1/projects/7/files", Params: url.Values{"file_name": []string{"common.json"}}}, requests[len(requests)-1])
+}
+
+func TestServerFailedImport(t *testing.T) {
+	s := NewServer(1, "key", "secret")
+	defer s.Close()
+	s.ImportPolls = 0
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	path := filepath.Join(tmpdir, "en.json")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"title": `), 0644))
+
+	upload, err := s.Client().UploadFile(path, "HIERARCHICAL_JSON", "en", true)
+	assert.Nil(t, err)
+	assert.Equal(t, onesky.ImportFailed, s.Tasks()[0].Status)
+	task, err := s.Client().ImportTask(upload.Import.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, onesky.ImportFailed, task.Status)
+	_, ok := s.Translation("en.json", "en")
+	assert.False(t, ok)
+
+	_, err = s.Client().UploadFile(path, "UNKNOWN", "en", true)
+	assert.NotNil(t, err)
+	assert.NotNil(t, s.AddFile("en.json", "HIERARCHICAL_JSON", "en", "{"))
+}
+
+func TestServerAuthorization(t *testing.T) {
+	s := NewServer(1, "key", "secret")
+	defer s.Close()
+
+	client := s.Client()
+	client.Secret = "wrong"
+	_, err := client.GetLanguages()
+	assert.Equal(t, "bad status: 401 Unauthorized", err.Error())
+	client = s.Client()
+	client.APIKey = "wrong"
+	_, err = client.GetLanguages()
+	assert.Equal(t, "bad status: 401 Unauthorized", err.Error())
+	client = s.Client()
+	client.ProjectID = 2
+	_, err = client.GetLanguages()
+	assert.Equal(t, "bad status: 404 Not Found", err.Error())
+	assert.Equal(t, 0, len(s.Requests()))
+
+	_, err = s.Client().GetLanguages()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(s.Requests()))
+}
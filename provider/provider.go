@@ -0,0 +1,204 @@
+// Package provider loads translations from OneSky at runtime and refreshes them in background
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// DefaultInterval is an interval of refresh used when Options.Interval is not set
+const DefaultInterval = 5 * time.Minute
+
+// File is a struct which contains name and format of OneSky file loaded by Provider
+type File struct {
+	Name   string
+	Format formats.Format
+}
+
+// Options is a struct which contains settings of Provider
+type Options struct {
+	// Files are OneSky files loaded in every locale, messages of earlier files win when keys are duplicated
+	Files []File
+	// Locales are loaded locales, all locales from GetLanguages are loaded when empty
+	Locales []string
+	// Interval is an interval of background refresh
+	Interval time.Duration
+	// Fallback are fallback chains used by T
+	Fallback fallback.Config
+	// OnError is called with errors of background refresh
+	OnError func(err error)
+	// Initial are translations used when initial load fails, e.g. loaded from snapshot bundle
+	Initial *Translations
+}
+
+// Provider is a struct which contains translations downloaded with DownloadFile and refreshes them. It is safe
+// for concurrent use, translations are replaced only when refresh of all files and locales succeeds.
+type Provider struct {
+	client onesky.API
+	opts   Options
+	start  sync.Once
+	// refreshing serializes refreshes, so slower refresh does not replace translations of later one
+	refreshing sync.Mutex
+
+	mu           sync.RWMutex
+	translations *Translations
+	updated      time.Time
+	err          error
+}
+
+// ConfigFiles returns files of project config which can be loaded by Provider
+func ConfigFiles(cfg *config.Config) ([]File, error) {
+	sources, err := cfg.Sources()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]File, 0, len(sources))
+	for _, s := range sources {
+		files = append(files, File{Name: s.Name, Format: formats.Format(s.Format)})
+	}
+
+	return files, nil
+}
+
+// New returns provider with translations loaded from OneSky, error is returned when initial load fails and
+// Options.Initial is not set. Error of initial load is reported by Status otherwise.
+func New(client onesky.API, opts Options) (*Provider, error) {
+	if len(opts.Files) == 0 {
+		return nil, fmt.Errorf("no files to load")
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+
+	p := &Provider{client: client, opts: opts}
+	if err := p.Refresh(); err != nil {
+		if opts.Initial == nil {
+			return nil, err
+		}
+		p.translations = opts.Initial
+	}
+
+	return p, nil
+}
+
+// Start refreshes translations every interval in background until context is done, only the first call of Start
+// starts refresh
+func (p *Provider) Start(ctx context.Context) {
+	p.start.Do(func() { go p.run(ctx) })
+}
+
+func (p *Provider) run(ctx context.Context) {
+	ticker := time.NewTicker(p.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.Refresh(); err != nil && p.opts.OnError != nil {
+				p.opts.OnError(err)
+			}
+		}
+	}
+}
+
+// Refresh downloads translations of all files and locales, last good translations are kept when it fails
+func (p *Provider) Refresh() error {
+	p.refreshing.Lock()
+	defer p.refreshing.Unlock()
+	translations, err := p.load()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+	if err != nil {
+		return err
+	}
+	p.translations, p.updated = translations, time.Now()
+
+	return nil
+}
+
+func (p *Provider) load() (*Translations, error) {
+	locales := p.opts.Locales
+	if len(locales) == 0 {
+		languages, err := p.client.GetLanguages()
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range languages {
+			locales = append(locales, l.Code)
+		}
+	}
+
+	catalogs := map[string]*formats.Catalog{}
+	for _, locale := range locales {
+		catalogs[locale] = formats.NewCatalog(locale)
+	}
+	for _, f := range p.opts.Files {
+		downloaded, err := fallback.Download(p.client.DownloadFile, f.Name, f.Format, locales)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s", f.Name, err)
+		}
+		for locale, c := range downloaded {
+			merged := catalogs[locale]
+			for _, m := range c.Messages {
+				if _, ok := merged.Lookup(m.Context, m.Key); !ok {
+					merged.Add(m)
+				}
+			}
+		}
+	}
+
+	return NewTranslations(catalogs, p.opts.Fallback), nil
+}
+
+func (p *Provider) current() *Translations {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.translations
+}
+
+// T returns translation of key in locale or its fallback locales, key is returned when translation is missing
+func (p *Provider) T(locale, key string) string {
+	return p.current().T(locale, key)
+}
+
+// Plural returns translation of plural form of key for count n, key is returned when translation is missing
+func (p *Provider) Plural(locale, key string, n int) string {
+	return p.current().Plural(locale, key, n)
+}
+
+// Lookup returns translation of key in plural category, category is empty for messages without plural forms
+func (p *Provider) Lookup(locale, key, category string) (string, bool) {
+	return p.current().Lookup(locale, key, category)
+}
+
+// Locales returns sorted locales which have translations
+func (p *Provider) Locales() []string {
+	return p.current().Locales()
+}
+
+// Translations returns current translations, they are not changed by later refreshes
+func (p *Provider) Translations() *Translations {
+	return p.current()
+}
+
+// Status returns time of last successful refresh and error of last refresh
+func (p *Provider) Status() (time.Time, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.updated, p.err
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/oneskytest"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testServer responds with translations of version, requests fail when version is negative
+type testServer struct {
+	mu      sync.Mutex
+	version int
+}
+
+func (s *testServer) setVersion(v int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version = v
+}
+
+func (s *testServer) respond(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.version < 0 {
+		return httpmock.NewStringResponse(500, `{"meta":{"status":500,"message":"Internal error"}}`), nil
+	}
+
+	q := req.URL.Query()
+	if q.Get("source_file_name") == "common.json" {
+		return httpmock.NewStringResponse(200, fmt.Sprintf(`{"title": "common %s", "ok": "OK %s"}`, q.Get("locale"), q.Get("locale"))), nil
+	}
+	if q.Get("locale") == "de" {
+		return httpmock.NewStringResponse(200, fmt.Sprintf(`{"title": "Titel %d", "save": ""}`, s.version)), nil
+	}
+	return httpmock.NewStringResponse(200, fmt.Sprintf(`{"title": "Title %d", "save": "Save"}`, s.version)), nil
+}
+
+func TestProvider(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	server := &testServer{version: 1}
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", server.respond)
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en"},{"code":"de"}]}`))
+
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	p, err := New(client, Options{
+		Files:    []File{File{Name: "app.json", Format: formats.HierarchicalJSON}, File{Name: "common.json", Format: formats.HierarchicalJSON}},
+		Fallback: fallback.Config{Default: "en"},
+	})
+	assert.Nil(t, err)
+	var _ Translator = p
+
+	assert.Equal(t, []string{"de", "en"}, p.Locales())
+	assert.Equal(t, "Titel 1", p.T("de", "title"))
+	assert.Equal(t, "Save", p.T("de", "save"))
+	assert.Equal(t, "OK de", p.T("de-AT", "ok"))
+	updated, err := p.Status()
+	assert.Nil(t, err)
+	assert.False(t, updated.IsZero())
+
+	server.setVersion(2)
+	assert.Nil(t, p.Refresh())
+	assert.Equal(t, "Titel 2", p.T("de", "title"))
+
+	before := p.Translations()
+	server.setVersion(-1)
+	assert.NotNil(t, p.Refresh())
+	assert.Equal(t, "Titel 2", p.T("de", "title"))
+	assert.Equal(t, before, p.Translations())
+	last, err := p.Status()
+	assert.NotNil(t, err)
+	assert.Equal(t, updated.Before(last), true)
+
+	_, err = New(client, Options{Files: []File{File{Name: "app.json", Format: formats.HierarchicalJSON}}})
+	assert.NotNil(t, err)
+	_, err = New(client, Options{})
+	assert.Equal(t, "no files to load", err.Error())
+}
+
+func TestProviderInitial(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	server := &testServer{version: -1}
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", server.respond)
+
+	en, err := formats.ParseString(formats.HierarchicalJSON, `{"title": "Bundled"}`)
+	assert.Nil(t, err)
+	initial := NewTranslations(map[string]*formats.Catalog{"en": en}, fallback.Config{})
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	p, err := New(client, Options{Files: []File{File{Name: "app.json", Format: formats.HierarchicalJSON}}, Locales: []string{"en"}, Initial: initial})
+	assert.Nil(t, err)
+	assert.Equal(t, "Bundled", p.T("en", "title"))
+	updated, err := p.Status()
+	assert.True(t, updated.IsZero())
+	assert.NotNil(t, err)
+
+	server.setVersion(1)
+	assert.Nil(t, p.Refresh())
+	assert.Equal(t, "Title 1", p.T("en", "title"))
+}
+
+func TestProviderStart(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	server := &testServer{version: 1}
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", server.respond)
+
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	errs := make(chan error, 100)
+	p, err := New(client, Options{
+		Files:    []File{File{Name: "app.json", Format: formats.HierarchicalJSON}},
+		Locales:  []string{"de"},
+		Interval: time.Millisecond,
+		OnError:  func(err error) { errs <- err },
+	})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		// refresh in progress finishes before httpmock is deactivated, later refreshes wait forever
+		cancel()
+		p.refreshing.Lock()
+	}()
+	p.Start(ctx)
+	p.Start(ctx)
+
+	server.setVersion(-1)
+	select {
+	case err := <-errs:
+		assert.NotNil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("refresh error was not reported")
+	}
+	assert.Equal(t, "Titel 1", p.T("de", "title"))
+
+	server.setVersion(3)
+	deadline := time.Now().Add(5 * time.Second)
+	for p.T("de", "title") != "Titel 3" && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, "Titel 3", p.T("de", "title"))
+}
+
+func TestProviderRefreshIsSerialized(t *testing.T) {
+	var calls, active, overlaps int32
+	release := make(chan struct{})
+	m := &oneskytest.Mock{
+		DownloadFileFunc: func(fileName, locale string) (string, error) {
+			if atomic.AddInt32(&active, 1) > 1 {
+				atomic.AddInt32(&overlaps, 1)
+			}
+			defer atomic.AddInt32(&active, -1)
+			n := atomic.AddInt32(&calls, 1)
+			if n == 2 {
+				<-release
+			}
+			return fmt.Sprintf(`{"title": "Title %d"}`, n), nil
+		},
+	}
+	p, err := New(m, Options{Files: []File{File{Name: "app.json", Format: formats.HierarchicalJSON}}, Locales: []string{"en"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "Title 1", p.T("en", "title"))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Nil(t, p.Refresh())
+	}()
+	for atomic.LoadInt32(&calls) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Nil(t, p.Refresh())
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, "Title 3", p.T("en", "title"))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&overlaps))
+}
+
+func TestProviderWithMock(t *testing.T) {
+	m := &oneskytest.Mock{
+		GetLanguagesFunc: func() ([]onesky.Language, error) {
+			return []onesky.Language{onesky.Language{Code: "en"}, onesky.Language{Code: "fr"}}, nil
+		},
+		DownloadFileFunc: func(fileName, locale string) (string, error) {
+			return `{"title": "` + locale + `"}`, nil
+		},
+	}
+	p, err := New(m, Options{Files: []File{File{Name: "app.json", Format: formats.HierarchicalJSON}}})
+	assert.Nil(t, err)
+	assert.Equal(t, "fr", p.T("fr-CA", "title"))
+	assert.Equal(t, 1, len(m.CallsTo("GetLanguages")))
+	assert.Equal(t, []interface{}{"app.json", "fr"}, m.CallsTo("DownloadFile")[1].Args)
+}
+
+func TestConfigFiles(t *testing.T) {
+	cfg := &config.Config{Files: []config.File{config.File{Source: "provider_test.go", Format: "HIERARCHICAL_JSON", Name: "app-{file}"}}, Output: "{locale}/{file}"}
+	files, err := ConfigFiles(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, []File{File{Name: "app-provider_test.go", Format: formats.HierarchicalJSON}}, files)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// Translator is an interface of translations used at runtime, it is implemented by Provider and Translations
+type Translator interface {
+	// T returns translation of key in locale or its fallback locales, key is returned when translation is missing
+	T(locale, key string) string
+	// Plural returns translation of plural form of key for count n, key is returned when translation is missing
+	Plural(locale, key string, n int) string
+	// Lookup returns translation of key in plural category, category is empty for messages without plural forms
+	Lookup(locale, key, category string) (string, bool)
+	// Locales returns sorted locales which have translations
+	Locales() []string
+}
+
+// Translations is an immutable set of catalogs mapped by locale with fallback chains. Key of message with context
+// is "context|key".
+type Translations struct {
+	catalogs map[string]*formats.Catalog
+	fallback fallback.Config
+}
+
+// NewTranslations returns translations of catalogs mapped by locale, catalogs must not be modified afterwards
+func NewTranslations(catalogs map[string]*formats.Catalog, f fallback.Config) *Translations {
+	return &Translations{catalogs: catalogs, fallback: f}
+}
+
+// T returns translation of key in locale or its fallback locales, "other" form of plural message is returned.
+// Key is returned when translation is missing.
+func (t *Translations) T(locale, key string) string {
+	if s, ok := t.Lookup(locale, key, ""); ok {
+		return s
+	}
+
+	return key
+}
+
+// Plural returns translation of plural form of key for count n, plural category is selected by rules of locale
+// which translates key. Key is returned when translation is missing.
+func (t *Translations) Plural(locale, key string, n int) string {
+	for _, l := range t.chain(locale) {
+		if s, ok := t.lookup(l, key, formats.PluralCategory(l, n)); ok {
+			return s
+		}
+	}
+
+	return key
+}
+
+// Lookup returns translation of key in plural category in locale or its fallback locales, empty translations are
+// skipped. Category is empty for messages without plural forms, "other" form is used when category is missing.
+func (t *Translations) Lookup(locale, key, category string) (string, bool) {
+	for _, l := range t.chain(locale) {
+		if s, ok := t.lookup(l, key, category); ok {
+			return s, true
+		}
+	}
+
+	return "", false
+}
+
+// Locales returns sorted locales of catalogs
+func (t *Translations) Locales() []string {
+	locales := make([]string, 0, len(t.catalogs))
+	for locale := range t.catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	return locales
+}
+
+func (t *Translations) chain(locale string) []string {
+	return append([]string{locale}, t.fallback.Chain(locale)...)
+}
+
+// lookup returns translation of key in single locale
+func (t *Translations) lookup(locale, key, category string) (string, bool) {
+	c, ok := t.catalogs[locale]
+	if !ok {
+		return "", false
+	}
+	m, ok := c.Lookup("", key)
+	if !ok {
+		i := strings.Index(key, "|")
+		if i < 0 {
+			return "", false
+		}
+		if m, ok = c.Lookup(key[:i], key[i+1:]); !ok {
+			return "", false
+		}
+	}
+
+	s := m.Value
+	if m.IsPlural() {
+		var found bool
+		if s, found = m.Plural[category]; !found || category == "" {
+			s = m.Plural[formats.Other]
+		}
+	}
+
+	return s, s != ""
+}
+
+// LookupFunc returns lookup of translations in locale which can be used by accessors generated by onesky gen go
+func LookupFunc(t Translator, locale string) func(key, category string) string {
+	return func(key, category string) string {
+		s, _ := t.Lookup(locale, key, category)
+		return s
+	}
+}
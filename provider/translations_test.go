@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTranslations(t *testing.T) *Translations {
+	en, err := formats.ParseString(formats.HierarchicalJSON, `{"title": "Title", "save": "Save", "files": {"one": "%d file", "other": "%d files"}}`)
+	assert.Nil(t, err)
+	pl, err := formats.ParseString(formats.HierarchicalJSON, `{"title": "Tytuł", "save": "", "files": {"one": "%d plik", "few": "%d pliki", "many": "%d plików", "other": "%d pliku"}}`)
+	assert.Nil(t, err)
+	po, err := formats.ParseString(formats.GNUPO, "msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Otwórz\"\n")
+	assert.Nil(t, err)
+	pl.Add(po.Messages[0])
+
+	return NewTranslations(map[string]*formats.Catalog{"en": en, "pl": pl}, fallback.Config{Default: "en"})
+}
+
+func TestTranslations(t *testing.T) {
+	tr := testTranslations(t)
+	var _ Translator = tr
+
+	assert.Equal(t, []string{"en", "pl"}, tr.Locales())
+	assert.Equal(t, "Tytuł", tr.T("pl", "title"))
+	assert.Equal(t, "Tytuł", tr.T("pl-PL", "title"))
+	assert.Equal(t, "Save", tr.T("pl", "save"))
+	assert.Equal(t, "Title", tr.T("de", "title"))
+	assert.Equal(t, "missing", tr.T("pl", "missing"))
+	assert.Equal(t, "%d pliku", tr.T("pl", "files"))
+	assert.Equal(t, "Otwórz", tr.T("pl", "menu|Open"))
+	assert.Equal(t, "menu|Open", tr.T("en", "menu|Open"))
+
+	assert.Equal(t, "%d pliki", tr.Plural("pl", "files", 3))
+	assert.Equal(t, "%d plików", tr.Plural("pl", "files", 5))
+	assert.Equal(t, "%d file", tr.Plural("de", "files", 1))
+	assert.Equal(t, "missing", tr.Plural("de", "missing", 1))
+
+	s, ok := tr.Lookup("pl", "files", formats.Two)
+	assert.True(t, ok)
+	assert.Equal(t, "%d pliku", s)
+	_, ok = tr.Lookup("fr", "missing", "")
+	assert.False(t, ok)
+
+	lookup := LookupFunc(tr, "pl")
+	assert.Equal(t, "%d plik", lookup("files", formats.One))
+	assert.Equal(t, "", lookup("missing", ""))
+}
@@ -0,0 +1,102 @@
+package onesky
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+)
+
+// PruneOptions is a struct which contains options of Prune
+type PruneOptions struct {
+	// Sources is a list of local source files, usually resolved by (*config.Config).Sources
+	Sources []config.Source
+	// Protect is a list of patterns (see path.Match) of remote files which are never deleted
+	Protect []string
+	// Confirm deletes orphaned files, without it Prune only lists them
+	Confirm bool
+}
+
+// PruneResult is a struct which contains summary of Prune
+type PruneResult struct {
+	// Orphans are remote files without local source file which are not protected
+	Orphans []string
+	// Protected are remote files without local source file which match one of protect patterns
+	Protected []string
+	// Deleted are orphaned files removed from OneSky
+	Deleted []string
+	// Errors are errors of failed deletes by file name
+	Errors map[string]error
+}
+
+// Prune finds files stored in OneSky which do not have local source file and deletes them when confirmed
+func (c *Client) Prune(ctx context.Context, opts PruneOptions) (PruneResult, error) {
+	if len(opts.Sources) == 0 {
+		return PruneResult{}, fmt.Errorf("no local source files, refusing to prune")
+	}
+	for _, pattern := range opts.Protect {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return PruneResult{}, fmt.Errorf("invalid protect pattern %s: %s", pattern, err)
+		}
+	}
+
+	local := map[string]bool{}
+	for _, s := range opts.Sources {
+		local[s.Name] = true
+	}
+
+	remote, err := c.listAllFiles(ctx)
+	if err != nil {
+		return PruneResult{}, err
+	}
+
+	result := PruneResult{}
+	for _, f := range remote {
+		if local[f.Name] {
+			continue
+		}
+		if isProtected(f.Name, opts.Protect) {
+			result.Protected = append(result.Protected, f.Name)
+			continue
+		}
+		result.Orphans = append(result.Orphans, f.Name)
+	}
+	sort.Strings(result.Orphans)
+	sort.Strings(result.Protected)
+
+	if !opts.Confirm {
+		return result, nil
+	}
+
+	for _, name := range result.Orphans {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		if err := c.deleteFile(ctx, name); err != nil {
+			if result.Errors == nil {
+				result.Errors = map[string]error{}
+			}
+			result.Errors[name] = err
+			continue
+		}
+		result.Deleted = append(result.Deleted, name)
+	}
+
+	if len(result.Errors) > 0 {
+		return result, fmt.Errorf("%d of %d deletes failed", len(result.Errors), len(result.Orphans))
+	}
+
+	return result, nil
+}
+
+func isProtected(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
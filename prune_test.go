@@ -0,0 +1,71 @@
+package onesky
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func registerPruneResponders(deleted *[]string) {
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/files", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"name":"a.yml"},{"name":"old.yml"},{"name":"legacy-app.yml"},{"name":"broken.yml"}]}`))
+	httpmock.RegisterResponder("DELETE", "https://platform.api.onesky.io/1/projects/1/files", func(req *http.Request) (*http.Response, error) {
+		name := req.URL.Query().Get("file_name")
+		if name == "broken.yml" {
+			return httpmock.NewStringResponse(500, ""), nil
+		}
+		*deleted = append(*deleted, name)
+		return httpmock.NewStringResponse(200, ""), nil
+	})
+}
+
+func TestPruneWithoutConfirm(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var deleted []string
+	registerPruneResponders(&deleted)
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	res, err := client.Prune(context.Background(), PruneOptions{
+		Sources: []config.Source{config.Source{Name: "a.yml"}},
+		Protect: []string{"legacy-*"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, PruneResult{
+		Orphans:   []string{"broken.yml", "old.yml"},
+		Protected: []string{"legacy-app.yml"},
+	}, res)
+	assert.Len(t, deleted, 0)
+}
+
+func TestPruneWithConfirm(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var deleted []string
+	registerPruneResponders(&deleted)
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	res, err := client.Prune(context.Background(), PruneOptions{
+		Sources: []config.Source{config.Source{Name: "a.yml"}},
+		Protect: []string{"legacy-*"},
+		Confirm: true,
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"old.yml"}, deleted)
+	assert.Equal(t, []string{"old.yml"}, res.Deleted)
+	assert.Len(t, res.Errors, 1)
+	assert.NotNil(t, res.Errors["broken.yml"])
+}
+
+func TestPruneWithFailure(t *testing.T) {
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	_, err := client.Prune(context.Background(), PruneOptions{Confirm: true})
+	assert.NotNil(t, err)
+
+	_, err = client.Prune(context.Background(), PruneOptions{Sources: []config.Source{config.Source{Name: "a.yml"}}, Protect: []string{"[a"}})
+	assert.NotNil(t, err)
+}
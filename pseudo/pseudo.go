@@ -0,0 +1,355 @@
+// Package pseudo generates pseudo-localized catalogs for testing of hard-coded strings and layout overflow
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package pseudo
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// Pseudo locales conventionally used for accented and right-to-left variants
+const (
+	AccentedLocale = "en-XA"
+	RTLLocale      = "ar-XB"
+)
+
+// Options is a struct which contains settings of pseudo-localization
+type Options struct {
+	// Accents replaces ASCII letters with accented ones
+	Accents bool
+	// Brackets wraps strings in [ and ] to reveal truncation and concatenation
+	Brackets bool
+	// Expansion is a percentage of text length added as padding to simulate longer translations
+	Expansion int
+	// RTL wraps words in right-to-left override marks to simulate right-to-left languages
+	RTL bool
+	// Locale is set as locale of pseudo-localized catalog when it is not empty
+	Locale string
+}
+
+// DefaultOptions are options of accented pseudo locale
+var DefaultOptions = Options{Accents: true, Brackets: true, Expansion: 30, Locale: AccentedLocale}
+
+// RTLOptions are options of right-to-left pseudo locale
+var RTLOptions = Options{RTL: true, Locale: RTLLocale}
+
+var accents = map[rune]rune{
+	'a': 'à', 'b': 'ƀ', 'c': 'ç', 'd': 'ð', 'e': 'é', 'f': 'ƒ', 'g': 'ĝ', 'h': 'ĥ', 'i': 'î', 'j': 'ĵ', 'k': 'ķ', 'l': 'ļ', 'm': 'ɱ',
+	'n': 'ñ', 'o': 'ö', 'p': 'þ', 'q': 'ǫ', 'r': 'ŕ', 's': 'š', 't': 'ţ', 'u': 'û', 'v': 'ṽ', 'w': 'ŵ', 'x': 'ẋ', 'y': 'ý', 'z': 'ž',
+	'A': 'Å', 'B': 'Ɓ', 'C': 'Ç', 'D': 'Đ', 'E': 'É', 'F': 'Ƒ', 'G': 'Ĝ', 'H': 'Ĥ', 'I': 'Î', 'J': 'Ĵ', 'K': 'Ķ', 'L': 'Ļ', 'M': 'Ṁ',
+	'N': 'Ñ', 'O': 'Ö', 'P': 'Þ', 'Q': 'Ǫ', 'R': 'Ŕ', 'S': 'Š', 'T': 'Ţ', 'U': 'Û', 'V': 'Ṽ', 'W': 'Ŵ', 'X': 'Ẋ', 'Y': 'Ý', 'Z': 'Ž',
+}
+
+// padding is repeated to expand strings, words allow line wrapping
+const padding = " one two three four five six seven eight nine ten"
+
+// Bidi control characters used by RTL mode
+const (
+	rlm = "\u200f"
+	rlo = "\u202e"
+	pdf = "\u202c"
+)
+
+// protectedPattern matches markup, entities, printf and {{template}} placeholders kept intact
+var protectedPattern = regexp.MustCompile(`^(?:<[a-zA-Z/!][^<>]*>|&(?:#\d+|#x[0-9a-fA-F]+|[a-zA-Z]+);|%%|%(?:\d+\$)?[-+#0']*(?:\d+|\*)?(?:\.(?:\d+|\*))?(?:hh|h|ll|l|L|q|j|z|t)?[diouxXeEfFgGaAcsSp@]|\{\{[^{}]*\}\})`)
+
+// Catalog returns pseudo-localized copy of catalog, keys and plural categories are kept. Source text or key is
+// pseudo-localized when message is not translated, e.g. in POT file. Plural messages get all forms required by
+// pseudo locale and Plural-Forms header is removed when locale changes, so it is generated for pseudo locale.
+func Catalog(c *formats.Catalog, opts Options) *formats.Catalog {
+	out := formats.NewCatalog(c.Locale)
+	if opts.Locale != "" {
+		out.Locale = opts.Locale
+	}
+	for _, f := range c.Header {
+		if f.Name == "Plural-Forms" && out.Locale != c.Locale {
+			continue
+		}
+		out.Header = append(out.Header, f)
+	}
+	out.HeaderComments, out.HeaderFlags = c.HeaderComments, c.HeaderFlags
+	if out.HeaderValue("Language") != "" {
+		out.SetHeader("Language", out.Locale)
+	}
+	required, _ := formats.RequiredPluralCategoriesFor(out.Locale)
+
+	for _, m := range c.Messages {
+		p := *m
+		if !m.IsPlural() {
+			p.Value = String(text(m), opts)
+			out.Add(&p)
+			continue
+		}
+
+		forms := pluralTexts(m)
+		p.Plural = make(map[string]string, len(forms))
+		for category, v := range forms {
+			p.Plural[category] = String(v, opts)
+		}
+		for _, category := range required {
+			if _, ok := p.Plural[category]; !ok {
+				p.Plural[category] = p.Plural[formats.Other]
+			}
+		}
+		out.Add(&p)
+	}
+
+	return out
+}
+
+// text returns translation of message, source text or key is used when message is not translated
+func text(m *formats.Message) string {
+	switch {
+	case m.Value != "":
+		return m.Value
+	case m.Source != "":
+		return m.Source
+	}
+
+	return m.Key
+}
+
+// pluralTexts returns plural forms of message, source forms or key and plural key are used when message is not
+// translated
+func pluralTexts(m *formats.Message) map[string]string {
+	for _, v := range m.Plural {
+		if v != "" {
+			return m.Plural
+		}
+	}
+	if len(m.SourcePlural) > 0 {
+		return m.SourcePlural
+	}
+
+	other := m.PluralKey
+	if other == "" {
+		other = m.Key
+	}
+
+	return map[string]string{formats.One: m.Key, formats.Other: other}
+}
+
+// String returns pseudo-localized string, placeholders, ICU MessageFormat syntax and markup are kept intact
+func String(s string, opts Options) string {
+	if s == "" {
+		return s
+	}
+
+	segments := split(s)
+	length := 0
+	for _, seg := range segments {
+		if !seg.protected {
+			length += utf8.RuneCountInString(seg.text)
+		}
+	}
+
+	var b strings.Builder
+	if opts.Brackets {
+		b.WriteString("[")
+	}
+	for _, seg := range segments {
+		if seg.protected {
+			b.WriteString(seg.text)
+			continue
+		}
+		text := seg.text
+		if opts.Accents {
+			text = strings.Map(accent, text)
+		}
+		if opts.RTL {
+			text = rtl(text)
+		}
+		b.WriteString(text)
+	}
+	// padding is rounded up, so short strings are expanded too
+	if n := (length*opts.Expansion + 99) / 100; n > 0 {
+		b.WriteString(pad(n))
+	}
+	if opts.Brackets {
+		b.WriteString("]")
+	}
+
+	return b.String()
+}
+
+func accent(r rune) rune {
+	if a, ok := accents[r]; ok {
+		return a
+	}
+
+	return r
+}
+
+// rtl wraps every word of text in right-to-left override
+func rtl(text string) string {
+	var b strings.Builder
+	word := false
+	for _, r := range text {
+		space := unicode.IsSpace(r)
+		if !space && !word {
+			b.WriteString(rlm + rlo)
+		}
+		if space && word {
+			b.WriteString(pdf + rlm)
+		}
+		word = !space
+		b.WriteRune(r)
+	}
+	if word {
+		b.WriteString(pdf + rlm)
+	}
+
+	return b.String()
+}
+
+// pad returns padding of n characters
+func pad(n int) string {
+	var b strings.Builder
+	for b.Len() < n {
+		b.WriteString(padding)
+	}
+
+	return b.String()[:n]
+}
+
+// segment is a part of string which is either translatable text or protected syntax
+type segment struct {
+	text      string
+	protected bool
+}
+
+// split splits string into text and protected segments
+func split(s string) []segment {
+	sp := &splitter{src: s}
+	sp.message(false, false)
+	if sp.pos < len(sp.src) {
+		// unmatched closing brace, rest of string is kept as text
+		sp.text(sp.src[sp.pos:])
+	}
+
+	// adjacent segments of the same kind are joined, e.g. letters of single word
+	var segments []segment
+	for _, seg := range sp.segments {
+		if n := len(segments); n > 0 && segments[n-1].protected == seg.protected {
+			segments[n-1].text += seg.text
+			continue
+		}
+		segments = append(segments, seg)
+	}
+
+	return segments
+}
+
+// splitter is a scanner of ICU MessageFormat patterns which records text and protected segments
+type splitter struct {
+	src      string
+	pos      int
+	segments []segment
+}
+
+func (sp *splitter) add(text string, protected bool) {
+	if text == "" {
+		return
+	}
+	sp.segments = append(sp.segments, segment{text: text, protected: protected})
+}
+
+func (sp *splitter) text(s string) {
+	sp.add(s, false)
+}
+
+func (sp *splitter) protect(s string) {
+	sp.add(s, true)
+}
+
+// message scans text until end of string or closing brace of nested message
+func (sp *splitter) message(nested, inPlural bool) {
+	for sp.pos < len(sp.src) {
+		rest := sp.src[sp.pos:]
+		if m := protectedPattern.FindString(rest); m != "" {
+			sp.protect(m)
+			sp.pos += len(m)
+			continue
+		}
+
+		switch rest[0] {
+		case '}':
+			if nested {
+				return
+			}
+			sp.text("}")
+			sp.pos++
+		case '{':
+			if !sp.argument() {
+				sp.text("{")
+				sp.pos++
+			}
+		case '#':
+			if inPlural {
+				sp.protect("#")
+			} else {
+				sp.text("#")
+			}
+			sp.pos++
+		default:
+			_, size := utf8.DecodeRuneInString(rest)
+			sp.text(rest[:size])
+			sp.pos += size
+		}
+	}
+}
+
+var (
+	simpleArgument  = regexp.MustCompile(`^\{\s*[\w.-]+\s*(?:,\s*\w+\s*(?:,[^{}]*)?)?\}`)
+	complexArgument = regexp.MustCompile(`^\{\s*[\w.-]+\s*,\s*(plural|select|selectordinal)\s*,\s*(?:offset:\s*\d+\s*)?`)
+	caseSelector    = regexp.MustCompile(`^\s*(=\d+|[\w-]+)\s*\{`)
+	caseEnd         = regexp.MustCompile(`^\s*\}`)
+)
+
+// argument scans ICU argument at current position, false is returned when it is not valid argument
+func (sp *splitter) argument() bool {
+	rest := sp.src[sp.pos:]
+	if m := simpleArgument.FindString(rest); m != "" {
+		sp.protect(m)
+		sp.pos += len(m)
+		return true
+	}
+
+	match := complexArgument.FindStringSubmatch(rest)
+	if match == nil {
+		return false
+	}
+
+	start, segments := sp.pos, len(sp.segments)
+	sp.protect(match[0])
+	sp.pos += len(match[0])
+	for {
+		rest = sp.src[sp.pos:]
+		if m := caseEnd.FindString(rest); m != "" {
+			sp.protect(m)
+			sp.pos += len(m)
+			return true
+		}
+		m := caseSelector.FindString(rest)
+		if m == "" {
+			break
+		}
+		sp.protect(m)
+		sp.pos += len(m)
+		sp.message(true, match[1] != "select")
+		if sp.pos == len(sp.src) {
+			break
+		}
+		sp.protect("}")
+		sp.pos++
+	}
+
+	// invalid argument, it is scanned as text
+	sp.pos, sp.segments = start, sp.segments[:segments]
+	return false
+}
@@ -0,0 +1,103 @@
+package pseudo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestString(t *testing.T) {
+	opts := Options{Accents: true, Brackets: true}
+	assert.Equal(t, "", String("", DefaultOptions))
+	assert.Equal(t, "[Ĥéļļö ŵöŕļð]", String("Hello world", opts))
+	assert.Equal(t, "[Ĥéļļö %1$s, ýöû ĥàṽé %d ñéŵ ɱéššàĝéš (100%%)]", String("Hello %1$s, you have %d new messages (100%%)", opts))
+	assert.Equal(t, "[Çļîçķ <a href=\"/x\">ĥéŕé</a>&nbsp;{{name}} {user} {n, number, integer}]", String("Click <a href=\"/x\">here</a>&nbsp;{{name}} {user} {n, number, integer}", opts))
+	assert.Equal(t, "[{count, plural, =0 {Ñö ɱéššàĝéš} one {# ɱéššàĝé} other {# ɱéššàĝéš}}]", String("{count, plural, =0 {No messages} one {# message} other {# messages}}", opts))
+	assert.Equal(t, "[{g, select, male {Ĥé #1} other {Ţĥéý}}]", String("{g, select, male {He #1} other {They}}", opts))
+	assert.Equal(t, "[Šéţ {} àñð } öŕ {]", String("Set {} and } or {", opts))
+	assert.Equal(t, "[{ñ, þļûŕàļ, öñé {àƀç]", String("{n, plural, one {abc", opts))
+
+	assert.Equal(t, "Save changes one two", String("Save changes", Options{Expansion: 60}))
+	assert.Equal(t, "Save %s o", String("Save %s", Options{Expansion: 30}))
+	assert.Equal(t, "[Šàṽé %s ]", String("Save %s", Options{Accents: true, Brackets: true, Expansion: 1}))
+}
+
+func TestStringRTL(t *testing.T) {
+	assert.Equal(t, "\u200f\u202eHello\u202c\u200f {name}\u200f\u202e!\u202c\u200f", String("Hello {name}!", RTLOptions))
+	assert.Equal(t, "\u200f\u202eĤéļļö\u202c\u200f \u200f\u202eŵöŕļð\u202c\u200f", String("Hello world", Options{Accents: true, RTL: true}))
+}
+
+func TestCatalog(t *testing.T) {
+	c, err := formats.ParseString(formats.GNUPO, `msgid ""
+msgstr ""
+"Language: en\n"
+
+#. Button label
+msgid "Save"
+msgstr "Save"
+
+msgid "item"
+msgid_plural "items"
+msgstr[0] "%d item"
+msgstr[1] "%d items"
+`)
+	assert.Nil(t, err)
+
+	p := Catalog(c, DefaultOptions)
+	assert.Equal(t, AccentedLocale, p.Locale)
+	assert.Equal(t, AccentedLocale, p.HeaderValue("Language"))
+	assert.Equal(t, "en", c.HeaderValue("Language"))
+
+	m, _ := p.Get("Save")
+	assert.Equal(t, "[Šàṽé o]", m.Value)
+	assert.Equal(t, []string{"Button label"}, m.ExtractedComments)
+	m, _ = p.Get("item")
+	assert.Equal(t, map[string]string{formats.One: "[%d îţéɱ o]", formats.Other: "[%d îţéɱš o]"}, m.Plural)
+	m, _ = c.Get("item")
+	assert.Equal(t, "%d item", m.Plural[formats.One])
+
+	var b bytes.Buffer
+	err = formats.Write(formats.GNUPO, &b, p)
+	assert.Nil(t, err)
+	assert.Contains(t, b.String(), "msgstr[1] \"[%d îţéɱš o]\"")
+}
+
+func TestCatalogRTLPluralForms(t *testing.T) {
+	c, err := formats.ParseString(formats.GNUPO, `msgid ""
+msgstr ""
+"Language: en\n"
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+msgid "item"
+msgid_plural "items"
+msgstr[0] "%d item"
+msgstr[1] "%d items"
+`)
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	assert.Nil(t, formats.Write(formats.GNUPO, &b, Catalog(c, RTLOptions)))
+	assert.Contains(t, b.String(), `"Plural-Forms: nplurals=6;`)
+	assert.Contains(t, b.String(), "msgstr[5] ")
+	assert.NotContains(t, b.String(), `msgstr[1] ""`)
+}
+
+func TestCatalogUntranslated(t *testing.T) {
+	c, err := formats.ParseString(formats.GNUPO, `msgid "Hello"
+msgstr ""
+
+msgid "item"
+msgid_plural "items"
+msgstr[0] ""
+msgstr[1] ""
+`)
+	assert.Nil(t, err)
+
+	p := Catalog(c, Options{Brackets: true})
+	m, _ := p.Get("Hello")
+	assert.Equal(t, "[Hello]", m.Value)
+	m, _ = p.Get("item")
+	assert.Equal(t, map[string]string{formats.One: "[item]", formats.Other: "[items]"}, m.Plural)
+}
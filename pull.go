@@ -0,0 +1,275 @@
+package onesky
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+)
+
+// DefaultPathTemplate is a path template used by Pull when PullOptions.PathTemplate is empty
+const DefaultPathTemplate = "{locale}/{file}"
+
+// DefaultWorkers is a number of concurrent downloads used when Workers option is not set
+const DefaultWorkers = 4
+
+const listFilesPerPage = 100
+
+// Statuses of pulled files
+const (
+	PullCreated   = "created"
+	PullUpdated   = "updated"
+	PullUnchanged = "unchanged"
+	PullFailed    = "failed"
+)
+
+// PullOptions is a struct which contains options of Pull
+type PullOptions struct {
+	// Dir is a directory to which translations are written
+	Dir string
+	// PathTemplate is a path of translation relative to Dir, see config.ExpandPath for placeholders
+	PathTemplate string
+	// Files limits pulled files, all files from ListFiles are pulled when empty
+	Files []string
+	// Locales limits pulled locales, all locales from GetLanguages are pulled when empty
+	Locales []string
+	// LocaleName maps OneSky locale code to name used in path, e.g. (*config.Config).LocalLocale
+	LocaleName func(code string) string
+	// Workers is a number of concurrent downloads
+	Workers int
+}
+
+// PulledFile is a struct which contains informations about single downloaded translation
+type PulledFile struct {
+	Name   string
+	Locale string
+	Path   string
+	Status string
+	Err    error
+}
+
+// PullResult is a struct which contains summary of Pull
+type PullResult struct {
+	Files []PulledFile
+}
+
+// Changed returns files which were created or updated
+func (r PullResult) Changed() []PulledFile {
+	return r.filter(PullCreated, PullUpdated)
+}
+
+// Failed returns files which could not be downloaded or written
+func (r PullResult) Failed() []PulledFile {
+	return r.filter(PullFailed)
+}
+
+func (r PullResult) filter(statuses ...string) []PulledFile {
+	var files []PulledFile
+	for _, f := range r.Files {
+		for _, s := range statuses {
+			if f.Status == s {
+				files = append(files, f)
+				break
+			}
+		}
+	}
+
+	return files
+}
+
+// Pull downloads every file of project in every project language to directory layout described by path template
+func (c *Client) Pull(ctx context.Context, opts PullOptions) (PullResult, error) {
+	files := opts.Files
+	if len(files) == 0 {
+		all, err := c.listAllFiles(ctx)
+		if err != nil {
+			return PullResult{}, err
+		}
+		for _, f := range all {
+			files = append(files, f.Name)
+		}
+	}
+
+	locales := opts.Locales
+	if len(locales) == 0 {
+		languages, err := c.getLanguages(ctx)
+		if err != nil {
+			return PullResult{}, err
+		}
+		for _, l := range languages {
+			locales = append(locales, l.Code)
+		}
+	}
+
+	template := opts.PathTemplate
+	if template == "" {
+		template = DefaultPathTemplate
+	}
+	localeName := opts.LocaleName
+	if localeName == nil {
+		localeName = func(code string) string { return code }
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+
+	jobs := make(chan PulledFile)
+	results := make(chan PulledFile)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- c.pullFile(ctx, opts.Dir, job)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, file := range files {
+			for _, locale := range locales {
+				job := PulledFile{
+					Name:   file,
+					Locale: locale,
+					Path:   filepath.Join(opts.Dir, config.ExpandPath(template, localeName(locale), file)),
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				select {
+				case jobs <- job:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	result := PullResult{}
+	for f := range results {
+		result.Files = append(result.Files, f)
+	}
+	sort.Sort(pulledFiles(result.Files))
+
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	if failed := result.Failed(); len(failed) > 0 {
+		return result, fmt.Errorf("%d of %d downloads failed, first error: %s", len(failed), len(result.Files), failed[0].Err)
+	}
+
+	return result, nil
+}
+
+func (c *Client) pullFile(ctx context.Context, dir string, f PulledFile) PulledFile {
+	// file names and locales come from OneSky, so they must not lead outside of directory
+	if !inside(dir, f.Path) {
+		f.Status, f.Err = PullFailed, fmt.Errorf("path %s is outside of %s", f.Path, dir)
+		return f
+	}
+
+	content, err := c.downloadFileContext(ctx, f.Name, f.Locale)
+	if err != nil {
+		f.Status, f.Err = PullFailed, err
+		return f
+	}
+
+	old, err := ioutil.ReadFile(f.Path)
+	switch {
+	case err == nil && bytes.Equal(old, []byte(content)):
+		f.Status = PullUnchanged
+		return f
+	case err == nil:
+		f.Status = PullUpdated
+	case os.IsNotExist(err):
+		f.Status = PullCreated
+	default:
+		f.Status, f.Err = PullFailed, err
+		return f
+	}
+
+	if err := writeFileAtomic(f.Path, []byte(content)); err != nil {
+		f.Status, f.Err = PullFailed, err
+	}
+
+	return f
+}
+
+func (c *Client) listAllFiles(ctx context.Context) ([]FileData, error) {
+	var files []FileData
+	for page := 1; ; page++ {
+		list, err := c.listFiles(ctx, page, listFilesPerPage)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, list...)
+		if len(list) < listFilesPerPage {
+			return files, nil
+		}
+	}
+}
+
+// inside returns whether path is inside dir after cleaning, e.g. "../x.json" is not
+func inside(dir, path string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	if err != nil {
+		return false
+	}
+
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
+
+// writeFileAtomic writes data to temporary file in the same directory and renames it, so readers never see partial content
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+type pulledFiles []PulledFile
+
+func (p pulledFiles) Len() int      { return len(p) }
+func (p pulledFiles) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p pulledFiles) Less(i, j int) bool {
+	if p[i].Name != p[j].Name {
+		return p[i].Name < p[j].Name
+	}
+
+	return p[i].Locale < p[j].Locale
+}
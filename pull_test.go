@@ -0,0 +1,168 @@
+package onesky
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func registerPullResponders() {
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/files", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"name":"a.yml"},{"name":"b.yml"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en-US"},{"code":"zh-TW"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		if q.Get("source_file_name") == "b.yml" && q.Get("locale") == "zh-TW" {
+			return httpmock.NewStringResponse(500, ""), nil
+		}
+		return httpmock.NewStringResponse(200, q.Get("source_file_name")+" "+q.Get("locale")), nil
+	})
+}
+
+func TestPullWithSuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerPullResponders()
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	os.MkdirAll(path.Join(tmpdir, "en-US"), 0755)
+	ioutil.WriteFile(path.Join(tmpdir, "en-US", "a.yml"), []byte("a.yml en-US"), 0644)
+
+	res, err := client.Pull(context.Background(), PullOptions{
+		Dir:        tmpdir,
+		Files:      []string{"a.yml"},
+		LocaleName: (&config.Config{LocaleAliases: map[string]string{"zh-TW": "zh_Hant"}}).LocalLocale,
+		Workers:    2,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, PullResult{Files: []PulledFile{
+		PulledFile{Name: "a.yml", Locale: "en-US", Path: path.Join(tmpdir, "en-US", "a.yml"), Status: PullUnchanged},
+		PulledFile{Name: "a.yml", Locale: "zh-TW", Path: path.Join(tmpdir, "zh_Hant", "a.yml"), Status: PullCreated},
+	}}, res)
+
+	res, err = client.Pull(context.Background(), PullOptions{Dir: tmpdir, Files: []string{"a.yml"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []PulledFile{
+		PulledFile{Name: "a.yml", Locale: "zh-TW", Path: path.Join(tmpdir, "zh-TW", "a.yml"), Status: PullCreated},
+	}, res.Changed())
+	assert.Equal(t, PullUnchanged, res.Files[0].Status)
+
+	content, err := ioutil.ReadFile(path.Join(tmpdir, "zh-TW", "a.yml"))
+	assert.Nil(t, err)
+	assert.Equal(t, "a.yml zh-TW", string(content))
+}
+
+func TestPullWithFailure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerPullResponders()
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	res, err := client.Pull(context.Background(), PullOptions{Dir: tmpdir, PathTemplate: "{locale}/{name}.{ext}"})
+	assert.NotNil(t, err)
+	assert.Len(t, res.Files, 4)
+	assert.Len(t, res.Changed(), 3)
+	assert.Equal(t, []PulledFile{
+		PulledFile{Name: "b.yml", Locale: "zh-TW", Path: path.Join(tmpdir, "zh-TW", "b.yml"), Status: PullFailed, Err: res.Failed()[0].Err},
+	}, res.Failed())
+
+	_, err = os.Stat(path.Join(tmpdir, "zh-TW", "b.yml"))
+	assert.True(t, os.IsNotExist(err))
+
+	entries, err := ioutil.ReadDir(path.Join(tmpdir, "zh-TW"))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestPullWithCanceledContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerPullResponders()
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = client.Pull(ctx, PullOptions{Dir: tmpdir})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPullCancelInterruptsRequest(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1, BaseURL: server.URL}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := client.Pull(ctx, PullOptions{Dir: tmpdir, Files: []string{"a.yml"}, Locales: []string{"en-US"}})
+		done <- err
+	}()
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.Equal(t, context.Canceled, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Pull was not interrupted by canceled context")
+	}
+}
+
+func TestPullOutsideDir(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/files", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"name":"../../x.json"},{"name":"a.json"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en"},{"code":".."}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", httpmock.NewStringResponder(200, "{}"))
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	dir := path.Join(tmpdir, "a", "b")
+
+	result, err := client.Pull(context.Background(), PullOptions{Dir: dir})
+	assert.NotNil(t, err)
+	assert.Equal(t, []PulledFile{PulledFile{Name: "a.json", Locale: "en", Path: path.Join(dir, "en", "a.json"), Status: PullCreated}}, result.Changed())
+	assert.Len(t, result.Failed(), 3)
+	for _, f := range result.Failed() {
+		assert.Contains(t, f.Err.Error(), "is outside of "+dir)
+	}
+	entries, err := ioutil.ReadDir(tmpdir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
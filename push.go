@@ -0,0 +1,338 @@
+package onesky
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+)
+
+// DefaultPollInterval is an interval of checking import task status used when PushOptions.PollInterval is not set
+const DefaultPollInterval = 2 * time.Second
+
+// Statuses of import tasks
+const (
+	ImportCompleted  = "completed"
+	ImportInProgress = "in-progress"
+	ImportFailed     = "failed"
+)
+
+// Statuses of pushed files
+const (
+	PushUploaded  = "uploaded"
+	PushUnchanged = "unchanged"
+	PushFailed    = "failed"
+)
+
+// PushOptions is a struct which contains options of Push
+type PushOptions struct {
+	// Sources is a list of local source files, usually resolved by (*config.Config).Sources
+	Sources []config.Source
+	// Locale is a locale of source files
+	Locale string
+	// Manifest is a path of file in which content hashes of pushed files are stored between pushes,
+	// when empty modification time of local file is compared with upload time of remote file
+	Manifest string
+	// Force uploads all files without checking for changes
+	Force bool
+	// Workers is a number of concurrent uploads
+	Workers int
+	// PollInterval is an interval of checking import task status
+	PollInterval time.Duration
+}
+
+// PushedFile is a struct which contains informations about single source file considered by Push
+type PushedFile struct {
+	Name   string
+	Path   string
+	Status string
+	Reason string
+	Import TaskData
+	Err    error
+}
+
+// PushResult is a struct which contains summary of Push
+type PushResult struct {
+	Files []PushedFile
+}
+
+// Uploaded returns files which were uploaded and imported
+func (r PushResult) Uploaded() []PushedFile {
+	return r.filter(PushUploaded)
+}
+
+// Failed returns files which could not be uploaded or imported
+func (r PushResult) Failed() []PushedFile {
+	return r.filter(PushFailed)
+}
+
+func (r PushResult) filter(status string) []PushedFile {
+	var files []PushedFile
+	for _, f := range r.Files {
+		if f.Status == status {
+			files = append(files, f)
+		}
+	}
+
+	return files
+}
+
+type pushManifest struct {
+	Files map[string]pushManifestEntry `json:"files"`
+}
+
+type pushManifestEntry struct {
+	Hash     string `json:"hash"`
+	ImportID int64  `json:"import_id"`
+	PushedAt int64  `json:"pushed_at"`
+}
+
+// Push uploads source files which changed since last push and waits until OneSky imports them
+func (c *Client) Push(ctx context.Context, opts PushOptions) (PushResult, error) {
+	manifest, err := loadPushManifest(opts.Manifest)
+	if err != nil {
+		return PushResult{}, err
+	}
+
+	remote := map[string]FileData{}
+	if !opts.Force {
+		files, err := c.listAllFiles(ctx)
+		if err != nil {
+			return PushResult{}, err
+		}
+		for _, f := range files {
+			remote[f.Name] = f
+		}
+	}
+
+	result := PushResult{}
+	var uploads []config.Source
+	hashes := map[string]string{}
+	for _, s := range opts.Sources {
+		hash, err := hashFile(s.Path)
+		if err != nil {
+			result.Files = append(result.Files, PushedFile{Name: s.Name, Path: s.Path, Status: PushFailed, Err: err})
+			continue
+		}
+		hashes[s.Name] = hash
+
+		reason, err := pushReason(s, hash, opts.Force, manifest, remote)
+		if err != nil {
+			result.Files = append(result.Files, PushedFile{Name: s.Name, Path: s.Path, Status: PushFailed, Err: err})
+			continue
+		}
+		if reason == "" {
+			result.Files = append(result.Files, PushedFile{Name: s.Name, Path: s.Path, Status: PushUnchanged})
+			continue
+		}
+		result.Files = append(result.Files, PushedFile{Name: s.Name, Path: s.Path, Reason: reason})
+		uploads = append(uploads, s)
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	jobs := make(chan config.Source)
+	pushed := map[string]PushedFile{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s := range jobs {
+				f := c.pushFile(ctx, s, opts.Locale, interval)
+				mu.Lock()
+				pushed[s.Name] = f
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, s := range uploads {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- s
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i, f := range result.Files {
+		p, ok := pushed[f.Name]
+		if !ok {
+			if f.Status == "" {
+				result.Files[i].Status, result.Files[i].Err = PushFailed, ctx.Err()
+			}
+			continue
+		}
+		p.Reason = f.Reason
+		result.Files[i] = p
+		if p.Status == PushUploaded {
+			manifest.Files[p.Name] = pushManifestEntry{Hash: hashes[p.Name], ImportID: p.Import.ID, PushedAt: time.Now().Unix()}
+		}
+	}
+	sort.Sort(pushedFiles(result.Files))
+
+	if opts.Manifest != "" && len(pushed) > 0 && !c.DryRun {
+		if err := manifest.save(opts.Manifest); err != nil {
+			return result, err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	if failed := result.Failed(); len(failed) > 0 {
+		return result, fmt.Errorf("%d of %d pushes failed, first error: %s", len(failed), len(result.Files), failed[0].Err)
+	}
+
+	return result, nil
+}
+
+// pushReason returns why source file has to be uploaded, empty reason means that file did not change
+func pushReason(s config.Source, hash string, force bool, manifest *pushManifest, remote map[string]FileData) (string, error) {
+	if force {
+		return "forced", nil
+	}
+
+	r, ok := remote[s.Name]
+	if !ok {
+		return "not uploaded yet", nil
+	}
+	if r.LastImport.Status == ImportFailed {
+		return "last import failed", nil
+	}
+
+	if entry, ok := manifest.Files[s.Name]; ok {
+		if entry.Hash != hash {
+			return "content changed", nil
+		}
+		return "", nil
+	}
+
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return "", err
+	}
+	if info.ModTime().Unix() > int64(r.UpoladedAtTimestamp) {
+		return "modified after last upload", nil
+	}
+
+	return "", nil
+}
+
+func (c *Client) pushFile(ctx context.Context, s config.Source, locale string, interval time.Duration) PushedFile {
+	f := PushedFile{Name: s.Name, Path: s.Path}
+	upload, err := c.uploadFile(ctx, s.Path, s.Name, s.Format, locale, s.KeepStrings)
+	if err != nil {
+		f.Status, f.Err = PushFailed, err
+		return f
+	}
+
+	task := upload.Import
+	if !c.DryRun {
+		task, err = c.waitForImport(ctx, upload.Import.ID, interval)
+	}
+	f.Import = task
+	switch {
+	case err != nil:
+		f.Status, f.Err = PushFailed, err
+	case task.Status != ImportCompleted:
+		f.Status, f.Err = PushFailed, fmt.Errorf("import task %d %s", task.ID, task.Status)
+	default:
+		f.Status = PushUploaded
+	}
+
+	return f
+}
+
+// waitForImport polls import task until it is not in progress anymore
+func (c *Client) waitForImport(ctx context.Context, importID int64, interval time.Duration) (TaskData, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		task, err := c.importTask(ctx, importID)
+		if err != nil {
+			return TaskData{}, err
+		}
+		if task.Status != ImportInProgress {
+			return task, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return task, ctx.Err()
+		}
+	}
+}
+
+func loadPushManifest(path string) (*pushManifest, error) {
+	m := &pushManifest{Files: map[string]pushManifestEntry{}}
+	if path == "" {
+		return m, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	if m.Files == nil {
+		m.Files = map[string]pushManifestEntry{}
+	}
+
+	return m, nil
+}
+
+func (m *pushManifest) save(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, append(data, '\n'))
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", err
+	}
+
+	return "sha256:" + hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+type pushedFiles []PushedFile
+
+func (p pushedFiles) Len() int           { return len(p) }
+func (p pushedFiles) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p pushedFiles) Less(i, j int) bool { return p[i].Name < p[j].Name }
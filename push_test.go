@@ -0,0 +1,118 @@
+package onesky
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go/config"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type pushRecorder struct {
+	sync.Mutex
+	uploaded []string
+	polls    int
+}
+
+func registerPushResponders(r *pushRecorder) {
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/files", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"name":"a.yml","last_import":{"id":1,"status":"completed"},"uploaded_at_timestamp":1},{"name":"b.yml","last_import":{"id":2,"status":"failed"},"uploaded_at_timestamp":4102444800},{"name":"c.yml","last_import":{"id":3,"status":"completed"},"uploaded_at_timestamp":4102444800}]}`))
+	httpmock.RegisterResponder("POST", "https://platform.api.onesky.io/1/projects/1/files", func(req *http.Request) (*http.Response, error) {
+		_, header, err := req.FormFile("file")
+		if err != nil {
+			return nil, err
+		}
+		r.Lock()
+		r.uploaded = append(r.uploaded, header.Filename)
+		r.Unlock()
+		return httpmock.NewStringResponse(201, `{"meta":{"status":201},"data":{"name":"`+header.Filename+`","import":{"id":154}}}`), nil
+	})
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/import-tasks/154", func(req *http.Request) (*http.Response, error) {
+		r.Lock()
+		defer r.Unlock()
+		r.polls++
+		if r.polls == 1 {
+			return httpmock.NewStringResponse(200, `{"meta":{"status":200},"data":{"id":154,"status":"in-progress"}}`), nil
+		}
+		return httpmock.NewStringResponse(200, `{"meta":{"status":200},"data":{"id":154,"status":"completed"}}`), nil
+	})
+}
+
+func TestPushWithSuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	recorder := &pushRecorder{}
+	registerPushResponders(recorder)
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	var sources []config.Source
+	for _, name := range []string{"a.yml", "b.yml", "c.yml", "d.yml"} {
+		ioutil.WriteFile(path.Join(tmpdir, name), []byte(name), 0666)
+		sources = append(sources, config.Source{Path: path.Join(tmpdir, name), Name: name, Format: "YAML", KeepStrings: true})
+	}
+	manifest := path.Join(tmpdir, "manifest.json")
+	opts := PushOptions{Sources: sources, Locale: "en", Manifest: manifest, Workers: 1, PollInterval: time.Millisecond}
+
+	res, err := client.Push(context.Background(), opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.yml", "b.yml", "d.yml"}, recorder.uploaded)
+	assert.Equal(t, 4, recorder.polls)
+	assert.Equal(t, []PushedFile{
+		PushedFile{Name: "a.yml", Path: sources[0].Path, Status: PushUploaded, Reason: "modified after last upload", Import: TaskData{ID: 154, OriginalID: 154.0, Status: "completed"}},
+		PushedFile{Name: "b.yml", Path: sources[1].Path, Status: PushUploaded, Reason: "last import failed", Import: TaskData{ID: 154, OriginalID: 154.0, Status: "completed"}},
+		PushedFile{Name: "c.yml", Path: sources[2].Path, Status: PushUnchanged},
+		PushedFile{Name: "d.yml", Path: sources[3].Path, Status: PushUploaded, Reason: "not uploaded yet", Import: TaskData{ID: 154, OriginalID: 154.0, Status: "completed"}},
+	}, res.Files)
+
+	m, err := loadPushManifest(manifest)
+	assert.Nil(t, err)
+	assert.Len(t, m.Files, 3)
+
+	recorder.uploaded = nil
+	ioutil.WriteFile(path.Join(tmpdir, "a.yml"), []byte("changed"), 0666)
+	res, err = client.Push(context.Background(), opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.yml", "b.yml", "d.yml"}, recorder.uploaded)
+	assert.Equal(t, "content changed", res.Files[0].Reason)
+	assert.Equal(t, PushUnchanged, res.Files[2].Status)
+}
+
+func TestPushWithFailure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/files", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[]}`))
+	httpmock.RegisterResponder("POST", "https://platform.api.onesky.io/1/projects/1/files", httpmock.NewStringResponder(201, `{"meta":{"status":201},"data":{"import":{"id":"155"}}}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/import-tasks/155", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":{"id":155,"status":"failed"}}`))
+	client := Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	ioutil.WriteFile(path.Join(tmpdir, "a.yml"), []byte("a"), 0666)
+	res, err := client.Push(context.Background(), PushOptions{
+		Sources: []config.Source{
+			config.Source{Path: path.Join(tmpdir, "a.yml"), Name: "a.yml", Format: "YAML"},
+			config.Source{Path: path.Join(tmpdir, "not_found.yml"), Name: "not_found.yml", Format: "YAML"},
+		},
+		Locale:   "en",
+		Manifest: path.Join(tmpdir, "manifest.json"),
+	})
+	assert.NotNil(t, err)
+	assert.Len(t, res.Failed(), 2)
+	assert.Equal(t, "import task 155 failed", res.Files[0].Err.Error())
+
+	m, err := loadPushManifest(path.Join(tmpdir, "manifest.json"))
+	assert.Nil(t, err)
+	assert.Len(t, m.Files, 0)
+}
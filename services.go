@@ -0,0 +1,31 @@
+package onesky
+
+// FileService is an interface of methods working with files of OneSky project, it is implemented by *Client
+type FileService interface {
+	ListFiles(page, perPage int) ([]FileData, error)
+	DownloadFile(fileName, locale string) (string, error)
+	UploadFile(file, fileFormat, locale string, keepStrings bool) (UploadData, error)
+	DeleteFile(fileName string) error
+	GetTranslationsStatus(fileName, locale string) (TranslationsStatus, error)
+}
+
+// TaskService is an interface of methods working with import tasks of OneSky project, it is implemented by *Client
+type TaskService interface {
+	ImportTask(importID int64) (TaskData, error)
+	ImportTasks(params map[string]interface{}) ([]TaskData, error)
+}
+
+// LanguageService is an interface of methods working with languages of OneSky project, it is implemented by *Client
+type LanguageService interface {
+	GetLanguages() ([]Language, error)
+}
+
+// API is an interface of all OneSky API methods of Client, consumers can depend on it and replace client
+// with oneskytest.Mock in unit tests
+type API interface {
+	FileService
+	TaskService
+	LanguageService
+}
+
+var _ API = (*Client)(nil)
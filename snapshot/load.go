@@ -0,0 +1,87 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/provider"
+)
+
+// Open reads manifest of bundle in dir of fsys, dir is slash separated and "." is root of fsys
+func Open(fsys fs.FS, dir string) (*Manifest, error) {
+	data, err := fs.ReadFile(fsys, path.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("%s: %s", ManifestFile, err)
+	}
+	if m.Schema != Schema {
+		return nil, fmt.Errorf("%s: unsupported schema %d", ManifestFile, m.Schema)
+	}
+
+	return m, nil
+}
+
+// Load reads bundle in dir of fsys and returns its translations, which have the same API as Provider.
+// Hashes of files are verified. Source locale of bundle is used as default fallback when f.Default is empty.
+func Load(fsys fs.FS, dir string, f fallback.Config) (*provider.Translations, *Manifest, error) {
+	m, err := Open(fsys, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	catalogs := map[string]*formats.Catalog{}
+	for _, l := range m.Locales {
+		catalogs[l.Code] = formats.NewCatalog(l.Code)
+	}
+	for _, file := range m.Files {
+		c, err := read(fsys, dir, file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", file.Path, err)
+		}
+		merged, ok := catalogs[file.Locale]
+		if !ok {
+			merged = formats.NewCatalog(file.Locale)
+			catalogs[file.Locale] = merged
+		}
+		for _, msg := range c.Messages {
+			if _, ok := merged.Lookup(msg.Context, msg.Key); !ok {
+				merged.Add(msg)
+			}
+		}
+	}
+	if f.Default == "" {
+		f.Default = m.SourceLocale
+	}
+
+	return provider.NewTranslations(catalogs, f), m, nil
+}
+
+// read reads and parses single file of bundle, error is returned when its hash does not match manifest
+func read(fsys fs.FS, dir string, file File) (*formats.Catalog, error) {
+	if !local(file.Path) {
+		return nil, fmt.Errorf("invalid path")
+	}
+	data, err := fs.ReadFile(fsys, path.Join(dir, file.Path))
+	if err != nil {
+		return nil, err
+	}
+	if hash(data) != file.SHA256 {
+		return nil, fmt.Errorf("sha256 does not match manifest")
+	}
+
+	c, err := formats.ParseString(formats.Format(file.Format), string(data))
+	if err != nil {
+		return nil, err
+	}
+	c.Locale = file.Locale
+
+	return c, nil
+}
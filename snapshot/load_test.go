@@ -0,0 +1,50 @@
+package snapshot
+
+import (
+	"embed"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed testdata/bundle
+var bundle embed.FS
+
+func TestLoadEmbedded(t *testing.T) {
+	translations, m, err := Load(bundle, "testdata/bundle", fallback.Config{})
+	assert.Nil(t, err)
+	var _ provider.Translator = translations
+
+	assert.Equal(t, "v1.2.0", m.Version)
+	assert.Equal(t, time.Date(2015, 6, 1, 10, 0, 0, 0, time.UTC), m.Created)
+	assert.Equal(t, []Locale{Locale{Code: "en", Name: "English", Progress: "100.0%"}, Locale{Code: "de", Name: "German", Progress: "50.0%"}}, m.Locales)
+	assert.Equal(t, []string{"de", "en"}, translations.Locales())
+	assert.Equal(t, "Titel", translations.T("de-AT", "title"))
+	assert.Equal(t, "Save", translations.T("de", "save"))
+	assert.Equal(t, "%d files", translations.Plural("de", "files", 2))
+}
+
+func TestLoadErrors(t *testing.T) {
+	manifest, err := bundle.ReadFile("testdata/bundle/manifest.json")
+	assert.Nil(t, err)
+	en, err := bundle.ReadFile("testdata/bundle/en/app.json")
+	assert.Nil(t, err)
+
+	fsys := fstest.MapFS{
+		"manifest.json": &fstest.MapFile{Data: manifest},
+		"en/app.json":   &fstest.MapFile{Data: en},
+		"de/app.json":   &fstest.MapFile{Data: []byte(`{"title": "Changed"}`)},
+	}
+	_, _, err = Load(fsys, ".", fallback.Config{})
+	assert.Equal(t, "de/app.json: sha256 does not match manifest", err.Error())
+
+	_, _, err = Load(fstest.MapFS{}, ".", fallback.Config{})
+	assert.NotNil(t, err)
+
+	_, err = Open(fstest.MapFS{"manifest.json": &fstest.MapFile{Data: []byte(`{"schema": 2}`)}}, ".")
+	assert.Equal(t, "manifest.json: unsupported schema 2", err.Error())
+}
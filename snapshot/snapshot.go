@@ -0,0 +1,239 @@
+// Package snapshot writes translations downloaded from OneSky into bundle directory with manifest and loads them
+// back from any fs.FS, e.g. embed.FS, so services can start without access to OneSky
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/provider"
+)
+
+// ManifestFile is a name of manifest in bundle directory
+const ManifestFile = "manifest.json"
+
+// Schema is a version of manifest format written by Create
+const Schema = 1
+
+// versionLayout is a layout of bundle version used when Options.Version is not set
+const versionLayout = "20060102T150405Z"
+
+// Manifest is a struct which contains informations about bundle, it is stored as manifest.json
+type Manifest struct {
+	Schema int `json:"schema"`
+	// Version is a version of bundle, time of creation by default
+	Version      string    `json:"version"`
+	ProjectID    int       `json:"project_id"`
+	SourceLocale string    `json:"source_locale,omitempty"`
+	Created      time.Time `json:"created"`
+	Locales      []Locale  `json:"locales"`
+	Files        []File    `json:"files"`
+}
+
+// Locale is a struct which contains informations about locale of bundle
+type Locale struct {
+	Code string `json:"code"`
+	Name string `json:"name,omitempty"`
+	// Progress is a translation progress of locale reported by GetLanguages, e.g. "92.5%"
+	Progress string `json:"progress,omitempty"`
+}
+
+// File is a struct which contains informations about single downloaded file of bundle
+type File struct {
+	Name   string `json:"name"`
+	Format string `json:"format"`
+	Locale string `json:"locale"`
+	// Path is a slash separated path of file relative to bundle directory
+	Path    string    `json:"path"`
+	SHA256  string    `json:"sha256"`
+	Size    int       `json:"size"`
+	Fetched time.Time `json:"fetched"`
+}
+
+// Options is a struct which contains settings of Create
+type Options struct {
+	// Files are OneSky files downloaded in every locale, messages of earlier files win when keys are duplicated
+	Files []provider.File
+	// Locales are downloaded locales, all locales from GetLanguages are downloaded when empty
+	Locales []string
+	// SourceLocale is stored in manifest and used as default fallback by Load
+	SourceLocale string
+	// Version is a version of bundle, time of creation is used when empty
+	Version string
+	// ProjectID is stored in manifest, ProjectID of client is used when it is not set and client is *onesky.Client
+	ProjectID int
+}
+
+// Create downloads all files in all locales and writes them with manifest into dir. Bundle is written into
+// temporary directory first and replaces dir only when all downloads succeed, so dir always contains complete bundle.
+// Existing non-empty dir is replaced only when it contains manifest, so other directories are never deleted.
+func Create(client onesky.API, dir string, opts Options) (*Manifest, error) {
+	if len(opts.Files) == 0 {
+		return nil, fmt.Errorf("no files to download")
+	}
+	if err := checkBundleDir(dir); err != nil {
+		return nil, err
+	}
+
+	languages, err := client.GetLanguages()
+	if err != nil {
+		return nil, err
+	}
+	projectID := opts.ProjectID
+	if c, ok := client.(*onesky.Client); ok && projectID == 0 {
+		projectID = c.ProjectID
+	}
+	m := &Manifest{Schema: Schema, Version: opts.Version, ProjectID: projectID, SourceLocale: opts.SourceLocale, Created: time.Now().UTC()}
+	if m.Version == "" {
+		m.Version = m.Created.Format(versionLayout)
+	}
+	m.Locales = locales(languages, opts.Locales)
+
+	parent := filepath.Dir(filepath.Clean(dir))
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return nil, err
+	}
+	tmp, err := ioutil.TempDir(parent, "."+filepath.Base(dir)+".")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	for _, f := range opts.Files {
+		for _, l := range m.Locales {
+			file, err := download(client, tmp, f, l.Code)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %s", f.Name, l.Code, err)
+			}
+			m.Files = append(m.Files, file)
+		}
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmp, ManifestFile), append(data, '\n'), 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(tmp, 0755); err != nil {
+		return nil, err
+	}
+	if err := replace(tmp, dir); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// checkBundleDir returns error when dir exists and it is not empty directory or bundle with manifest
+func checkBundleDir(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(dir, ManifestFile)); err != nil {
+		return fmt.Errorf("%s is not empty and it does not contain %s", dir, ManifestFile)
+	}
+
+	return nil
+}
+
+// replace renames tmp to dir, old dir is moved aside first and restored when rename fails, then it is removed
+func replace(tmp, dir string) error {
+	old := ""
+	if _, err := os.Stat(dir); err == nil {
+		old = tmp + ".old"
+		if err := os.Rename(dir, old); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		if old != "" {
+			os.Rename(old, dir)
+		}
+		return err
+	}
+	if old != "" {
+		return os.RemoveAll(old)
+	}
+
+	return nil
+}
+
+// locales returns locales of bundle with progress of languages, all languages are returned when list is empty
+func locales(languages []onesky.Language, list []string) []Locale {
+	byCode := map[string]onesky.Language{}
+	for _, l := range languages {
+		byCode[l.Code] = l
+	}
+	if len(list) == 0 {
+		for _, l := range languages {
+			list = append(list, l.Code)
+		}
+	}
+
+	out := make([]Locale, 0, len(list))
+	for _, code := range list {
+		l := byCode[code]
+		out = append(out, Locale{Code: code, Name: l.EnglishName, Progress: l.TranslationProgress})
+	}
+
+	return out
+}
+
+// download downloads file in locale into bundle directory, content is parsed to make sure bundle can be loaded
+func download(client onesky.API, dir string, f provider.File, locale string) (File, error) {
+	p := path.Join(locale, f.Name)
+	if !local(p) {
+		return File{}, fmt.Errorf("invalid path %s", p)
+	}
+
+	content, err := client.DownloadFile(f.Name, locale)
+	if err != nil {
+		return File{}, err
+	}
+	fetched := time.Now().UTC()
+	if _, err := formats.ParseString(f.Format, content); err != nil {
+		return File{}, err
+	}
+
+	name := filepath.Join(dir, filepath.FromSlash(p))
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return File{}, err
+	}
+	if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+		return File{}, err
+	}
+
+	return File{Name: f.Name, Format: string(f.Format), Locale: locale, Path: p, SHA256: hash([]byte(content)), Size: len(content), Fetched: fetched}, nil
+}
+
+// local returns whether slash separated path stays inside bundle directory
+func local(p string) bool {
+	return p == path.Clean(p) && !path.IsAbs(p) && p != ".." && !strings.HasPrefix(p, "../")
+}
+
+func hash(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,153 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/oneskytest"
+	"github.com/SebastianCzoch/onesky-go/provider"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func registerResponders(status int) {
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en","english_name":"English","translation_progress":"100.0%"},{"code":"pl","english_name":"Polish","translation_progress":"50.0%"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("locale") == "pl" {
+			return httpmock.NewStringResponse(status, `{"title": "Tytuł"}`), nil
+		}
+		return httpmock.NewStringResponse(200, `{"title": "Title", "save": "Save"}`), nil
+	})
+}
+
+func TestCreate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerResponders(200)
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	dir := filepath.Join(tmpdir, "bundle")
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "old"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, ManifestFile), []byte("{}"), 0644))
+
+	m, err := Create(client, dir, Options{Files: []provider.File{provider.File{Name: "app.json", Format: formats.HierarchicalJSON}}, SourceLocale: "en", Version: "v1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", m.Version)
+	assert.Equal(t, 1, m.ProjectID)
+	assert.Equal(t, []Locale{Locale{Code: "en", Name: "English", Progress: "100.0%"}, Locale{Code: "pl", Name: "Polish", Progress: "50.0%"}}, m.Locales)
+	assert.Equal(t, 2, len(m.Files))
+	assert.Equal(t, "pl/app.json", m.Files[1].Path)
+	assert.Equal(t, len(`{"title": "Tytuł"}`), m.Files[1].Size)
+	assert.False(t, m.Files[1].Fetched.IsZero())
+
+	_, err = os.Stat(filepath.Join(dir, "old"))
+	assert.True(t, os.IsNotExist(err))
+	content, err := ioutil.ReadFile(filepath.Join(dir, "pl", "app.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"title": "Tytuł"}`, string(content))
+
+	translations, loaded, err := Load(os.DirFS(dir), ".", fallback.Config{})
+	assert.Nil(t, err)
+	assert.Equal(t, m.Files, loaded.Files)
+	assert.Equal(t, "Tytuł", translations.T("pl", "title"))
+	assert.Equal(t, "Save", translations.T("pl", "save"))
+
+	m, err = Create(client, dir, Options{Files: []provider.File{provider.File{Name: "app.json", Format: formats.HierarchicalJSON}}, Locales: []string{"pl"}})
+	assert.Nil(t, err)
+	assert.Equal(t, m.Created.Format("20060102T150405Z"), m.Version)
+	assert.Equal(t, []Locale{Locale{Code: "pl", Name: "Polish", Progress: "50.0%"}}, m.Locales)
+	_, err = os.Stat(filepath.Join(dir, "en"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCreateFailureKeepsBundle(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerResponders(500)
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	dir := filepath.Join(tmpdir, "bundle")
+	assert.Nil(t, os.MkdirAll(dir, 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, ManifestFile), []byte("{}"), 0644))
+
+	_, err = Create(client, dir, Options{Files: []provider.File{provider.File{Name: "app.json", Format: formats.HierarchicalJSON}}})
+	assert.NotNil(t, err)
+	content, err := ioutil.ReadFile(filepath.Join(dir, ManifestFile))
+	assert.Nil(t, err)
+	assert.Equal(t, "{}", string(content))
+	entries, err := ioutil.ReadDir(tmpdir)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+
+	_, err = Create(client, dir, Options{})
+	assert.Equal(t, "no files to download", err.Error())
+}
+
+func TestCreateKeepsOtherDirectory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerResponders(200)
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpdir, "main.go"), []byte("package main"), 0644))
+
+	_, err = Create(client, tmpdir, Options{Files: []provider.File{provider.File{Name: "app.json", Format: formats.HierarchicalJSON}}})
+	assert.Equal(t, tmpdir+" is not empty and it does not contain manifest.json", err.Error())
+	content, err := ioutil.ReadFile(filepath.Join(tmpdir, "main.go"))
+	assert.Nil(t, err)
+	assert.Equal(t, "package main", string(content))
+
+	dir := filepath.Join(tmpdir, "empty")
+	assert.Nil(t, os.MkdirAll(dir, 0755))
+	_, err = Create(client, dir, Options{Files: []provider.File{provider.File{Name: "app.json", Format: formats.HierarchicalJSON}}})
+	assert.Nil(t, err)
+	entries, err := ioutil.ReadDir(tmpdir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(entries))
+}
+
+func TestCreateWithMock(t *testing.T) {
+	m := &oneskytest.Mock{
+		GetLanguagesFunc: func() ([]onesky.Language, error) {
+			return []onesky.Language{onesky.Language{Code: "en"}, onesky.Language{Code: "de"}}, nil
+		},
+		DownloadFileFunc: func(fileName, locale string) (string, error) {
+			if locale == "de" {
+				return "", fmt.Errorf("not found")
+			}
+			return `{"title": "Title"}`, nil
+		},
+	}
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpdir)
+	dir := filepath.Join(tmpdir, "bundle")
+	files := []provider.File{provider.File{Name: "app.json", Format: formats.HierarchicalJSON}}
+
+	manifest, err := Create(m, dir, Options{Files: files, Locales: []string{"en"}, ProjectID: 7})
+	assert.Nil(t, err)
+	assert.Equal(t, 7, manifest.ProjectID)
+	assert.Equal(t, []interface{}{"app.json", "en"}, m.CallsTo("DownloadFile")[0].Args)
+
+	_, err = Create(m, dir, Options{Files: files})
+	assert.EqualError(t, err, "app.json de: not found")
+	loaded, err := Open(os.DirFS(dir), ".")
+	assert.Nil(t, err)
+	assert.Equal(t, 7, loaded.ProjectID)
+}
@@ -0,0 +1,227 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// icuArgument is a single argument of ICU MessageFormat pattern
+type icuArgument struct {
+	name  string
+	typ   string
+	cases []string
+}
+
+// icuParser is a recursive descent parser of ICU MessageFormat patterns
+type icuParser struct {
+	src  []rune
+	pos  int
+	args []icuArgument
+}
+
+// parseICU parses ICU MessageFormat pattern and returns its arguments, nested arguments included
+func parseICU(s string) ([]icuArgument, error) {
+	p := &icuParser{src: []rune(s)}
+	if err := p.message(false, false); err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, fmt.Errorf("unmatched } at offset %d", p.pos)
+	}
+
+	return p.args, nil
+}
+
+// message parses text and arguments until end of pattern or closing brace of nested message
+func (p *icuParser) message(nested, inPlural bool) error {
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '\'':
+			p.quoted(inPlural)
+		case '{':
+			if err := p.argument(); err != nil {
+				return err
+			}
+		case '}':
+			if nested {
+				return nil
+			}
+			return fmt.Errorf("unmatched } at offset %d", p.pos)
+		default:
+			p.pos++
+		}
+	}
+	if nested {
+		return fmt.Errorf("unclosed { in nested message")
+	}
+
+	return nil
+}
+
+// quoted skips apostrophe quoting, doubled apostrophe is a literal one and quoting runs until next apostrophe
+func (p *icuParser) quoted(inPlural bool) {
+	p.pos++
+	if p.pos == len(p.src) {
+		return
+	}
+	if p.src[p.pos] == '\'' {
+		p.pos++
+		return
+	}
+	if c := p.src[p.pos]; c != '{' && c != '}' && c != '|' && !(c == '#' && inPlural) {
+		return
+	}
+
+	for p.pos < len(p.src) {
+		if p.src[p.pos] == '\'' {
+			if p.pos+1 < len(p.src) && p.src[p.pos+1] == '\'' {
+				p.pos += 2
+				continue
+			}
+			p.pos++
+			return
+		}
+		p.pos++
+	}
+}
+
+func (p *icuParser) argument() error {
+	start := p.pos
+	p.pos++
+	p.skipSpace()
+	name := p.identifier()
+	if name == "" {
+		return fmt.Errorf("missing argument name at offset %d", start)
+	}
+	arg := icuArgument{name: name}
+	p.skipSpace()
+	if p.accept('}') {
+		p.args = append(p.args, arg)
+		return nil
+	}
+	if !p.accept(',') {
+		return fmt.Errorf("expected , or } after argument %s", name)
+	}
+
+	p.skipSpace()
+	arg.typ = p.identifier()
+	if arg.typ == "" {
+		return fmt.Errorf("missing type of argument %s", name)
+	}
+	p.skipSpace()
+	if p.accept('}') {
+		p.args = append(p.args, arg)
+		return nil
+	}
+	if !p.accept(',') {
+		return fmt.Errorf("expected , or } after type of argument %s", name)
+	}
+
+	switch arg.typ {
+	case "plural", "selectordinal", "select":
+		index := len(p.args)
+		p.args = append(p.args, arg)
+		cases, err := p.cases(arg)
+		if err != nil {
+			return err
+		}
+		p.args[index].cases = cases
+		return nil
+	}
+
+	// style of simple argument, e.g. {n, number, ::currency/EUR}
+	for depth := 0; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				p.pos++
+				p.args = append(p.args, arg)
+				return nil
+			}
+			depth--
+		}
+	}
+
+	return fmt.Errorf("unclosed argument %s", name)
+}
+
+func (p *icuParser) cases(arg icuArgument) ([]string, error) {
+	plural := arg.typ != "select"
+	p.skipSpace()
+	if plural && strings.HasPrefix(string(p.src[p.pos:]), "offset:") {
+		p.pos += len("offset:")
+		p.skipSpace()
+		if p.identifier() == "" {
+			return nil, fmt.Errorf("missing offset of argument %s", arg.name)
+		}
+	}
+
+	var cases []string
+	for {
+		p.skipSpace()
+		if p.pos == len(p.src) {
+			return nil, fmt.Errorf("unclosed argument %s", arg.name)
+		}
+		if p.accept('}') {
+			break
+		}
+
+		selector := ""
+		if plural && p.accept('=') {
+			selector = "=" + p.identifier()
+		} else {
+			selector = p.identifier()
+		}
+		if selector == "" || selector == "=" {
+			return nil, fmt.Errorf("invalid selector of argument %s at offset %d", arg.name, p.pos)
+		}
+		p.skipSpace()
+		if !p.accept('{') {
+			return nil, fmt.Errorf("expected { after selector %s of argument %s", selector, arg.name)
+		}
+		if err := p.message(true, plural); err != nil {
+			return nil, err
+		}
+		p.pos++
+		cases = append(cases, selector)
+	}
+
+	for _, c := range cases {
+		if c == "other" {
+			return cases, nil
+		}
+	}
+
+	return nil, fmt.Errorf("argument %s has no other case", arg.name)
+}
+
+func (p *icuParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '.' {
+			break
+		}
+		p.pos++
+	}
+
+	return string(p.src[start:p.pos])
+}
+
+func (p *icuParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *icuParser) accept(r rune) bool {
+	if p.pos < len(p.src) && p.src[p.pos] == r {
+		p.pos++
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,42 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseICU(t *testing.T) {
+	args, err := parseICU("Hello {name}, you have {count, plural, offset:1 =0 {no messages} one {# message from {sender}} other {# messages}} on {day, date, short}")
+	assert.Nil(t, err)
+	assert.Equal(t, []icuArgument{
+		icuArgument{name: "name"},
+		icuArgument{name: "count", typ: "plural", cases: []string{"=0", "one", "other"}},
+		icuArgument{name: "sender"},
+		icuArgument{name: "day", typ: "date"},
+	}, args)
+
+	args, err = parseICU("It''s '{quoted}' {g, select, male {he} female {she} other {they}} {n, number, ::currency/EUR}")
+	assert.Nil(t, err)
+	assert.Equal(t, []icuArgument{
+		icuArgument{name: "g", typ: "select", cases: []string{"male", "female", "other"}},
+		icuArgument{name: "n", typ: "number"},
+	}, args)
+
+	for _, invalid := range []string{
+		"{",
+		"}",
+		"{name",
+		"{ }",
+		"{count, plural, one {# item}}",
+		"{count, plural, one {# item} other {# items}",
+		"{count, plural, one # item other {# items}}",
+		"{count plural}",
+		"{count, }",
+		"{count, number, integer",
+		"{count, plural, = {a} other {b}}",
+	} {
+		_, err := parseICU(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
@@ -0,0 +1,189 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Kinds of placeholders
+const (
+	PrintfPlaceholder   = "printf"
+	NamedPlaceholder    = "named"
+	TemplatePlaceholder = "template"
+)
+
+// Placeholder is a struct which contains informations about single placeholder of translatable string
+type Placeholder struct {
+	Kind string
+	// Name is an argument name or position, it is empty for sequential printf placeholders
+	Name string
+	// Type is a normalized printf conversion, e.g. d for %i, or ICU argument type, e.g. plural
+	Type string
+}
+
+var (
+	printfPattern   = regexp.MustCompile(`%%|%(?:(\d+)\$)?[-+#0']*(?:\d+|\*)?(?:\.(?:\d+|\*))?(?:hh|h|ll|l|L|q|j|z|t)?([diouxXeEfFgGaAcsSp@])`)
+	templatePattern = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+	bracePattern    = regexp.MustCompile(`\{(\w+)\}`)
+)
+
+// printfTypes normalizes printf conversions which accept the same argument type
+var printfTypes = map[string]string{"i": "d", "X": "x", "F": "f", "E": "e", "G": "g", "A": "a", "S": "s"}
+
+// analysis is a result of parsing placeholders of string
+type analysis struct {
+	placeholders []Placeholder
+	icu          []icuArgument
+	// icuErr is an error of ICU MessageFormat parser, it is nil for strings without braces
+	icuErr error
+}
+
+// analyze extracts printf, {{template}} and ICU placeholders of string
+func analyze(s string) analysis {
+	a := analysis{}
+	for _, match := range printfPattern.FindAllStringSubmatch(s, -1) {
+		if match[0] == "%%" {
+			continue
+		}
+		typ := match[2]
+		if t, ok := printfTypes[typ]; ok {
+			typ = t
+		}
+		a.placeholders = append(a.placeholders, Placeholder{Kind: PrintfPlaceholder, Name: match[1], Type: typ})
+	}
+
+	for _, match := range templatePattern.FindAllStringSubmatch(s, -1) {
+		a.placeholders = append(a.placeholders, Placeholder{Kind: TemplatePlaceholder, Name: match[1]})
+	}
+	rest := templatePattern.ReplaceAllString(s, "")
+	if !strings.ContainsAny(rest, "{}") {
+		return a
+	}
+
+	a.icu, a.icuErr = parseICU(rest)
+	if a.icuErr != nil {
+		for _, match := range bracePattern.FindAllStringSubmatch(rest, -1) {
+			a.placeholders = append(a.placeholders, Placeholder{Kind: NamedPlaceholder, Name: match[1]})
+		}
+		return a
+	}
+	for _, arg := range a.icu {
+		a.placeholders = append(a.placeholders, Placeholder{Kind: NamedPlaceholder, Name: arg.name, Type: arg.typ})
+	}
+
+	return a
+}
+
+// Placeholders returns printf, {{template}} and ICU placeholders of string, placeholders of each kind are in order
+// of appearance. Braces of invalid ICU MessageFormat string are matched as {name} placeholders.
+func Placeholders(s string) []Placeholder {
+	return analyze(s).placeholders
+}
+
+// String returns placeholder as it is written in translatable string
+func (p Placeholder) String() string {
+	switch p.Kind {
+	case PrintfPlaceholder:
+		if p.Name != "" {
+			return "%" + p.Name + "$" + p.Type
+		}
+		return "%" + p.Type
+	case TemplatePlaceholder:
+		return "{{" + p.Name + "}}"
+	}
+	if p.Type != "" {
+		return "{" + p.Name + ", " + p.Type + "}"
+	}
+
+	return "{" + p.Name + "}"
+}
+
+// comparePlaceholders returns problems of target placeholders, missing placeholders are reported only when strict
+func comparePlaceholders(source, target []Placeholder, strict bool) []string {
+	var problems []string
+	problems = append(problems, compareSequential(filter(source, isSequential), filter(target, isSequential), strict)...)
+	problems = append(problems, compareNamed(filter(source, isNamed), filter(target, isNamed), strict)...)
+
+	return problems
+}
+
+func isSequential(p Placeholder) bool {
+	return p.Kind == PrintfPlaceholder && p.Name == ""
+}
+
+func isNotICU(p Placeholder) bool {
+	return p.Kind != NamedPlaceholder
+}
+
+func isNamed(p Placeholder) bool {
+	return !isSequential(p)
+}
+
+func filter(list []Placeholder, f func(p Placeholder) bool) []Placeholder {
+	var out []Placeholder
+	for _, p := range list {
+		if f(p) {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+// compareSequential compares printf placeholders without position, their order has to be kept
+func compareSequential(source, target []Placeholder, strict bool) []string {
+	var problems []string
+	for i := 0; i < len(source) || i < len(target); i++ {
+		switch {
+		case i >= len(target):
+			if strict {
+				problems = append(problems, fmt.Sprintf("missing placeholder %s", source[i]))
+			}
+		case i >= len(source):
+			problems = append(problems, fmt.Sprintf("unexpected placeholder %s", target[i]))
+		case source[i].Type != target[i].Type:
+			problems = append(problems, fmt.Sprintf("placeholder %d is %s in source but %s in translation", i+1, source[i], target[i]))
+		}
+	}
+
+	return problems
+}
+
+// compareNamed compares sets of named and positional placeholders, their order can be changed
+func compareNamed(source, target []Placeholder, strict bool) []string {
+	types := func(list []Placeholder) (map[string]Placeholder, []string) {
+		m := map[string]Placeholder{}
+		var order []string
+		for _, p := range list {
+			id := p.Kind + ":" + p.Name
+			if _, ok := m[id]; !ok {
+				order = append(order, id)
+				m[id] = p
+			}
+		}
+		return m, order
+	}
+	src, srcOrder := types(source)
+	dst, dstOrder := types(target)
+
+	var problems []string
+	for _, id := range srcOrder {
+		d, ok := dst[id]
+		switch {
+		case !ok:
+			if strict {
+				problems = append(problems, fmt.Sprintf("missing placeholder %s", src[id]))
+			}
+		case d.Type != src[id].Type:
+			problems = append(problems, fmt.Sprintf("placeholder %s in source is %s in translation", src[id], d))
+		}
+	}
+	for _, id := range dstOrder {
+		if _, ok := src[id]; !ok {
+			problems = append(problems, fmt.Sprintf("unexpected placeholder %s", dst[id]))
+		}
+	}
+
+	return problems
+}
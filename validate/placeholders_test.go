@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	a := analyze("%s has %1$d items, 100%% {{user}} {count, number}")
+	assert.Nil(t, a.icuErr)
+	assert.Equal(t, []Placeholder{
+		Placeholder{Kind: PrintfPlaceholder, Type: "s"},
+		Placeholder{Kind: PrintfPlaceholder, Name: "1", Type: "d"},
+		Placeholder{Kind: TemplatePlaceholder, Name: "user"},
+		Placeholder{Kind: NamedPlaceholder, Name: "count", Type: "number"},
+	}, a.placeholders)
+
+	a = analyze("Hello {name")
+	assert.NotNil(t, a.icuErr)
+	assert.Nil(t, a.placeholders)
+
+	a = analyze("%i %X %ld 100% sure")
+	assert.Equal(t, []Placeholder{
+		Placeholder{Kind: PrintfPlaceholder, Type: "d"},
+		Placeholder{Kind: PrintfPlaceholder, Type: "x"},
+		Placeholder{Kind: PrintfPlaceholder, Type: "d"},
+	}, a.placeholders)
+}
+
+func TestComparePlaceholders(t *testing.T) {
+	problems := func(source, target string, strict bool) []string {
+		return comparePlaceholders(analyze(source).placeholders, analyze(target).placeholders, strict)
+	}
+
+	assert.Nil(t, problems("%s has %d items", "%s hat %d Artikel", true))
+	assert.Nil(t, problems("%1$s has %2$d items", "%2$d Artikel hat %1$s", true))
+	assert.Nil(t, problems("Hi {name}, {{count}} new", "{{count}} neu, Hallo {name}", true))
+	assert.Equal(t, []string{"placeholder 2 is %d in source but %s in translation"}, problems("%s has %d items", "%s hat %s Artikel", true))
+	assert.Equal(t, []string{"missing placeholder %d"}, problems("%s has %d items", "%s hat Artikel", true))
+	assert.Nil(t, problems("%s has %d items", "%s hat Artikel", false))
+	assert.Equal(t, []string{"unexpected placeholder %d"}, problems("one item", "%d Artikel", false))
+	assert.Equal(t, []string{"missing placeholder {name}", "unexpected placeholder {nmae}"}, problems("Hi {name}", "Hallo {nmae}", true))
+	assert.Equal(t, []string{"placeholder {n, number} in source is {n, date} in translation"}, problems("{n, number}", "{n, date}", true))
+	assert.Equal(t, []string{"missing placeholder {{count}}", "unexpected placeholder %1$s"}, problems("{{count}}", "%1$s", true))
+}
+
+func TestPlaceholders(t *testing.T) {
+	assert.Equal(t, []Placeholder{
+		Placeholder{Kind: PrintfPlaceholder, Type: "s"},
+		Placeholder{Kind: NamedPlaceholder, Name: "count", Type: "plural"},
+	}, Placeholders("%s: {count, plural, one {# file} other {# files}}"))
+	assert.Equal(t, []Placeholder{Placeholder{Kind: NamedPlaceholder, Name: "name"}}, Placeholders("Hi {name} {"))
+	assert.Nil(t, Placeholders("100%% done"))
+}
@@ -0,0 +1,75 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var tagPattern = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9:-]*)(?:\s[^<>]*?)?(/?)>`)
+
+// voidElements are HTML elements without closing tag
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// tags returns names of opening and self-closing tags of string and error when tags are not balanced
+func tags(s string) ([]string, error) {
+	var names, stack []string
+	for _, match := range tagPattern.FindAllStringSubmatch(s, -1) {
+		closing, name, selfClosing := match[1] == "/", strings.ToLower(match[2]), match[3] == "/"
+		switch {
+		case closing:
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return names, fmt.Errorf("unexpected closing tag </%s>", name)
+			}
+			stack = stack[:len(stack)-1]
+		case selfClosing || voidElements[name]:
+			names = append(names, name)
+		default:
+			names = append(names, name)
+			stack = append(stack, name)
+		}
+	}
+	if len(stack) > 0 {
+		return names, fmt.Errorf("unclosed tag <%s>", stack[len(stack)-1])
+	}
+
+	return names, nil
+}
+
+// compareTags returns problems of markup of target, missing tags are reported only when strict
+func compareTags(source, target string, strict bool) []string {
+	srcTags, srcErr := tags(source)
+	dstTags, dstErr := tags(target)
+
+	var problems []string
+	if dstErr != nil && srcErr == nil {
+		problems = append(problems, dstErr.Error())
+	}
+
+	counts := map[string]int{}
+	for _, name := range srcTags {
+		counts[name]++
+	}
+	for _, name := range dstTags {
+		counts[name]--
+	}
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		switch n := counts[name]; {
+		case n > 0 && strict:
+			problems = append(problems, fmt.Sprintf("missing tag <%s>", name))
+		case n < 0:
+			problems = append(problems, fmt.Sprintf("unexpected tag <%s>", name))
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTags(t *testing.T) {
+	names, err := tags(`<b>Bold</b> <a href="/x">link</a><br> <img src="a.png"/> 1 < 2`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b", "a", "br", "img"}, names)
+
+	_, err = tags("<b>Bold<i>italic</b></i>")
+	assert.NotNil(t, err)
+	_, err = tags("<b>Bold")
+	assert.NotNil(t, err)
+}
+
+func TestCompareTags(t *testing.T) {
+	assert.Nil(t, compareTags("<b>Bold</b> text", "Text <B>fett</B>", true))
+	assert.Equal(t, []string{"unclosed tag <b>"}, compareTags("<b>Bold</b>", "<b>Fett", true))
+	assert.Equal(t, []string{"missing tag <b>", "unexpected tag <i>"}, compareTags("<b>Bold</b>", "<i>Fett</i>", true))
+	assert.Equal(t, []string{"unexpected tag <i>"}, compareTags("<b>Bold</b>", "<i>Fett</i>", false))
+	assert.Nil(t, compareTags("<b>Bold", "<b>Fett", true))
+}
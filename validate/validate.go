@@ -0,0 +1,206 @@
+// Package validate checks translations downloaded from OneSky against source strings
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+)
+
+// Rules of violations
+const (
+	RulePlaceholders = "placeholders"
+	RuleICU          = "icu"
+	RuleTags         = "tags"
+	RulePlural       = "plural"
+)
+
+// Violation is a struct which contains informations about single problem of translation
+type Violation struct {
+	Key     string `json:"key"`
+	Context string `json:"context,omitempty"`
+	// Category is a plural category of translation, it is empty for messages without plurals
+	Category string `json:"category,omitempty"`
+	Rule     string `json:"rule"`
+	Message  string `json:"message"`
+}
+
+// String returns violation as "key[category]: rule: message"
+func (v Violation) String() string {
+	key := v.Key
+	if v.Context != "" {
+		key = v.Context + "|" + key
+	}
+	if v.Category != "" {
+		key += "[" + v.Category + "]"
+	}
+
+	return fmt.Sprintf("%s: %s: %s", key, v.Rule, v.Message)
+}
+
+// Catalog validates translations of catalog against source catalog, locale of translations is used for
+// plural rules. Messages which do not exist in source and empty translations are skipped.
+func Catalog(source, translation *formats.Catalog) []Violation {
+	var violations []Violation
+	for _, m := range translation.Messages {
+		s, ok := source.Lookup(m.Context, m.Key)
+		if !ok {
+			continue
+		}
+		violations = append(violations, Message(translation.Locale, s, m)...)
+	}
+
+	return violations
+}
+
+// Message validates translation of single message in locale against source message
+func Message(locale string, source, translation *formats.Message) []Violation {
+	var violations []Violation
+	add := func(category, rule string, messages ...string) {
+		for _, msg := range messages {
+			violations = append(violations, Violation{Key: translation.Key, Context: translation.Context, Category: category, Rule: rule, Message: msg})
+		}
+	}
+
+	if !translation.IsPlural() {
+		if translation.Value != "" {
+			for _, p := range checkString(locale, sourceText(source, formats.Other), translation.Value, true) {
+				add("", p.rule, p.message)
+			}
+		}
+		return violations
+	}
+
+	if categories, ok := formats.PluralCategoriesFor(locale); ok {
+		required, _ := formats.RequiredPluralCategoriesFor(locale)
+		add("", RulePlural, comparePluralCategories(locale, categories, required, translation.Plural)...)
+	}
+	for _, category := range formats.PluralCategories {
+		value, ok := translation.Plural[category]
+		if !ok || value == "" {
+			continue
+		}
+		// forms other than "other" may omit the count, e.g. "one item"
+		for _, p := range checkString(locale, sourceText(source, category), value, category == formats.Other) {
+			add(category, p.rule, p.message)
+		}
+	}
+
+	return violations
+}
+
+// sourceText returns source form of plural category, "other" form is used when category is missing in source
+func sourceText(m *formats.Message, category string) string {
+	if !m.IsPlural() {
+		return m.Value
+	}
+	if v, ok := m.Plural[category]; ok {
+		return v
+	}
+
+	return m.Plural[formats.Other]
+}
+
+// problem is a single problem of translated string found by rule
+type problem struct {
+	rule    string
+	message string
+}
+
+// checkString returns problems of translated string, missing placeholders and tags are reported only when strict
+func checkString(locale, source, target string, strict bool) []problem {
+	var problems []problem
+	add := func(rule string, messages ...string) {
+		for _, msg := range messages {
+			problems = append(problems, problem{rule: rule, message: msg})
+		}
+	}
+
+	src, dst := analyze(source), analyze(target)
+	if src.icuErr == nil && dst.icuErr != nil {
+		add(RuleICU, fmt.Sprintf("invalid ICU MessageFormat: %s", dst.icuErr))
+		// named placeholders of broken pattern can not be compared
+		src.placeholders, dst.placeholders = filter(src.placeholders, isNotICU), filter(dst.placeholders, isNotICU)
+	}
+	if categories, ok := formats.PluralCategoriesFor(locale); ok {
+		// ICU MessageFormat requires other case in every plural argument
+		required, _ := formats.RequiredPluralCategoriesFor(locale)
+		if !contains(required, formats.Other) {
+			required = append(required, formats.Other)
+		}
+		for _, arg := range dst.icu {
+			if arg.typ != "plural" {
+				continue
+			}
+			cases := map[string]string{}
+			for _, c := range arg.cases {
+				if !strings.HasPrefix(c, "=") {
+					cases[c] = c
+				}
+			}
+			for _, p := range comparePluralCategories(locale, categories, required, cases) {
+				add(RulePlural, fmt.Sprintf("argument %s: %s", arg.name, p))
+			}
+		}
+	}
+	add(RulePlaceholders, comparePlaceholders(src.placeholders, dst.placeholders, strict)...)
+	add(RuleTags, compareTags(source, target, strict)...)
+
+	return problems
+}
+
+// comparePluralCategories returns problems of plural forms which do not fit categories of locale, only required
+// categories have to be present, other categories of locale are optional
+func comparePluralCategories(locale string, categories, required []string, forms map[string]string) []string {
+	var problems []string
+	for _, c := range required {
+		if _, ok := forms[c]; !ok {
+			problems = append(problems, fmt.Sprintf("missing plural category %s required by %s", c, locale))
+		}
+	}
+	used := map[string]bool{}
+	for _, c := range categories {
+		used[c] = true
+	}
+	for _, c := range formats.PluralCategories {
+		if _, ok := forms[c]; ok && !used[c] {
+			problems = append(problems, fmt.Sprintf("plural category %s is not used by %s", c, locale))
+		}
+	}
+	var invalid []string
+	for c := range forms {
+		if !isPluralCategory(c) {
+			invalid = append(invalid, c)
+		}
+	}
+	sort.Strings(invalid)
+	for _, c := range invalid {
+		problems = append(problems, fmt.Sprintf("invalid plural category %s", c))
+	}
+
+	return problems
+}
+
+func isPluralCategory(c string) bool {
+	for _, category := range formats.PluralCategories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
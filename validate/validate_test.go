@@ -0,0 +1,97 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	source := formats.NewCatalog("en")
+	source.Add(&formats.Message{Key: "welcome", Value: "Hello <b>%s</b>"})
+	source.Add(&formats.Message{Key: "inbox", Value: "{count, plural, one {# message} other {# messages}}"})
+	source.Add(&formats.Message{Key: "items", Plural: map[string]string{formats.One: "one item", formats.Other: "%d items"}})
+	source.Add(&formats.Message{Key: "ok", Value: "OK"})
+
+	translation := formats.NewCatalog("pl")
+	translation.Add(&formats.Message{Key: "welcome", Value: "Cześć <b>%d"})
+	translation.Add(&formats.Message{Key: "inbox", Value: "{count, plural, one {# wiadomość} other {# wiadomości}"})
+	translation.Add(&formats.Message{Key: "items", Plural: map[string]string{formats.One: "%d element", formats.Few: "%d elementy", formats.Two: "%d", formats.Other: "%s elementu"}})
+	translation.Add(&formats.Message{Key: "ok", Value: ""})
+	translation.Add(&formats.Message{Key: "extra", Value: "%s"})
+
+	violations := Catalog(source, translation)
+	var list []string
+	for _, v := range violations {
+		list = append(list, v.String())
+	}
+	assert.Equal(t, []string{
+		"welcome: placeholders: placeholder 1 is %s in source but %d in translation",
+		"welcome: tags: unclosed tag <b>",
+		"inbox: icu: invalid ICU MessageFormat: unclosed argument count",
+		"items: plural: missing plural category many required by pl",
+		"items: plural: plural category two is not used by pl",
+		"items[one]: placeholders: unexpected placeholder %d",
+		"items[other]: placeholders: placeholder 1 is %d in source but %s in translation",
+	}, list)
+	assert.Equal(t, Violation{Key: "items", Category: formats.One, Rule: RulePlaceholders, Message: "unexpected placeholder %d"}, violations[5])
+}
+
+func TestMessageICUPlural(t *testing.T) {
+	source := &formats.Message{Key: "inbox", Context: "mail", Value: "{count, plural, one {# message} other {# messages}}"}
+	translation := &formats.Message{Key: "inbox", Context: "mail", Value: "{count, plural, =0 {Keine} one {# Nachricht} few {# Nachrichten} other {# Nachrichten}}"}
+
+	violations := Message("de", source, translation)
+	assert.Equal(t, []Violation{
+		Violation{Key: "inbox", Context: "mail", Rule: RulePlural, Message: "argument count: plural category few is not used by de"},
+	}, violations)
+	assert.Equal(t, "mail|inbox: plural: argument count: plural category few is not used by de", violations[0].String())
+
+	translation.Value = "{count, plural, other {# Nachrichten}}"
+	assert.Equal(t, "argument count: missing plural category one required by de", Message("de", source, translation)[0].Message)
+
+	// unknown locale has no plural rules
+	assert.Nil(t, Message("xx", source, translation))
+}
+
+func TestCatalogPolishPO(t *testing.T) {
+	source, err := formats.ParseString(formats.GNUPO, `msgid ""
+msgstr ""
+"Language: en\n"
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d file"
+msgstr[1] "%d files"
+`)
+	assert.Nil(t, err)
+	translation, err := formats.ParseString(formats.GNUPO, `msgid ""
+msgstr ""
+"Language: pl\n"
+"Plural-Forms: nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d plik"
+msgstr[1] "%d pliki"
+msgstr[2] "%d plików"
+`)
+	assert.Nil(t, err)
+
+	assert.Nil(t, Catalog(source, translation))
+	forms := map[string][]string{
+		"ru": []string{formats.One, formats.Few, formats.Many},
+		"uk": []string{formats.One, formats.Few, formats.Many},
+		"cs": []string{formats.One, formats.Few, formats.Other},
+		"lt": []string{formats.One, formats.Few, formats.Other},
+	}
+	for locale, categories := range forms {
+		m := &formats.Message{Key: "files", Plural: map[string]string{}}
+		for _, category := range categories {
+			m.Plural[category] = "%d"
+		}
+		assert.Nil(t, Message(locale, source.Messages[0], m), locale)
+	}
+}
@@ -0,0 +1,334 @@
+// Package xtext builds golang.org/x/text/message catalogs from translations downloaded from OneSky
+// Copyright (c) 2015 Sebastian Czoch <sebastian@czoch.eu>. All rights reserved.
+// Use of this source code is governed by a GNU v2 license found in the LICENSE file.
+package xtext
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/fallback"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/SebastianCzoch/onesky-go/validate"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// Download downloads file in every language of project and returns catalog builder with its messages, source
+// locale is used to number placeholders of messages, see Build.
+func Download(client onesky.API, fileName string, format formats.Format, sourceLocale string, opts ...catalog.Option) (*catalog.Builder, error) {
+	languages, err := client.GetLanguages()
+	if err != nil {
+		return nil, err
+	}
+	var locales []string
+	for _, l := range languages {
+		locales = append(locales, l.Code)
+	}
+
+	catalogs, err := fallback.Download(client.DownloadFile, fileName, format, locales)
+	if err != nil {
+		return nil, err
+	}
+
+	return Build(catalogs, sourceLocale, opts...)
+}
+
+// Build returns catalog builder with messages of catalogs mapped by locale. Messages are set under their keys,
+// key of message with context is "context|key", so they can be printed with message.NewPrinter(tag).Sprintf(key, ...).
+//
+// Printf placeholders keep their positions, named placeholders, e.g. {name} or {{name}}, are numbered after them in
+// order of message of source locale, so arguments are passed in the same order for every language. Plural messages
+// and messages which consist of single ICU plural argument are mapped to plural.Selectf of count argument, which is
+// an argument named count or first integer printf placeholder.
+func Build(catalogs map[string]*formats.Catalog, sourceLocale string, opts ...catalog.Option) (*catalog.Builder, error) {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	b := catalog.NewBuilder(opts...)
+	for _, locale := range locales {
+		if err := Add(b, catalogs[locale], catalogs[sourceLocale]); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// Add sets translated messages of catalog in its locale, source catalog is used to number named placeholders,
+// translation itself is used when source is nil or does not contain message
+func Add(b *catalog.Builder, c, source *formats.Catalog) error {
+	tag, err := language.Parse(c.Locale)
+	if err != nil {
+		return fmt.Errorf("invalid locale %q: %s", c.Locale, err)
+	}
+
+	for _, m := range c.Messages {
+		if m.Obsolete {
+			continue
+		}
+		key := m.Key
+		if m.Context != "" {
+			key = m.Context + "|" + m.Key
+		}
+		s := m
+		if source != nil {
+			if sm, ok := source.Lookup(m.Context, m.Key); ok {
+				s = sm
+			}
+		}
+
+		msg, ok := convert(m, numbering(s))
+		if !ok {
+			continue
+		}
+		if err := b.Set(tag, key, msg); err != nil {
+			return fmt.Errorf("%s %s: %s", c.Locale, key, err)
+		}
+	}
+
+	return nil
+}
+
+// arguments maps named placeholders of message to positions of arguments
+type arguments struct {
+	named map[string]int
+	// count is a position of argument which selects plural form
+	count int
+}
+
+// numbering returns positions of arguments of message
+func numbering(m *formats.Message) arguments {
+	args := arguments{named: map[string]int{}}
+	printf := 0
+	var named []string
+	for _, t := range texts(m) {
+		sequential := 0
+		for _, p := range validate.Placeholders(t) {
+			if p.Kind != validate.PrintfPlaceholder {
+				if _, ok := args.named[p.Name]; !ok {
+					args.named[p.Name] = 0
+					named = append(named, p.Name)
+				}
+				continue
+			}
+			position := 0
+			if p.Name == "" {
+				sequential++
+				position = sequential
+			} else {
+				position, _ = strconv.Atoi(p.Name)
+			}
+			if position > printf {
+				printf = position
+			}
+			if args.count == 0 && (p.Type == "d" || p.Type == "u") {
+				args.count = position
+			}
+		}
+	}
+	for i, name := range named {
+		args.named[name] = printf + i + 1
+	}
+	if n, ok := args.named["count"]; ok {
+		args.count = n
+	}
+	if args.count == 0 {
+		args.count = 1
+	}
+
+	return args
+}
+
+// texts returns source texts of message, plural forms are in canonical order
+func texts(m *formats.Message) []string {
+	if !m.IsPlural() {
+		return []string{m.Value}
+	}
+	var list []string
+	for _, category := range formats.PluralCategories {
+		if v, ok := m.Plural[category]; ok {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+// convert returns catalog message of translated message, false is returned when message is not translated
+func convert(m *formats.Message, args arguments) (catalog.Message, bool) {
+	if !m.IsPlural() {
+		if m.Value == "" {
+			return nil, false
+		}
+		if cases, ok := icuPlural(m.Value, args); ok {
+			return cases, true
+		}
+		return catalog.String(format(m.Value, args, false)), true
+	}
+
+	forms := map[string]string{}
+	for category, v := range m.Plural {
+		if v != "" {
+			forms[category] = format(v, args, false)
+		}
+	}
+	if len(forms) == 0 {
+		return nil, false
+	}
+
+	return selectf(forms, args), true
+}
+
+// selectf returns plural.Selectf message of forms, exact matches are first and "other" is last
+func selectf(forms map[string]string, args arguments) catalog.Message {
+	var exact []string
+	for selector := range forms {
+		if strings.HasPrefix(selector, "=") {
+			exact = append(exact, selector)
+		}
+	}
+	sort.Strings(exact)
+
+	var cases []interface{}
+	for _, selector := range exact {
+		cases = append(cases, selector, forms[selector])
+	}
+	for _, category := range formats.PluralCategories {
+		if v, ok := forms[category]; ok {
+			cases = append(cases, category, v)
+		}
+	}
+
+	return plural.Selectf(args.count, "%d", cases...)
+}
+
+var (
+	printfPattern   = regexp.MustCompile(`^%(?:(\d+)\$)?([-+#0']*)(\d+)?(?:\.(\d+))?(?:hh|h|ll|l|L|q|j|z|t)?([diouxXeEfFgGaAcsSp@])`)
+	templatePattern = regexp.MustCompile(`^\{\{\s*([^{}\s]+)\s*\}\}`)
+	simplePattern   = regexp.MustCompile(`^\{\s*([\w.-]+)\s*(?:,\s*\w+\s*(?:,[^{}]*)?)?\}`)
+	pluralPattern   = regexp.MustCompile(`^\{\s*([\w.-]+)\s*,\s*plural\s*,`)
+	selectorPattern = regexp.MustCompile(`^\s*(=\d+|zero|one|two|few|many|other)\s*\{`)
+)
+
+// printfVerbs maps printf conversions to Go verbs
+var printfVerbs = map[string]string{"i": "d", "u": "d", "S": "s", "@": "v", "F": "f", "a": "g", "A": "G"}
+
+// format converts placeholders of string to Go format with explicit argument indexes, e.g. %1$s and {name} to %[1]s
+// and %[2]v. Percent signs which do not start placeholder are escaped. Number sign is replaced with count argument
+// in plural cases.
+func format(s string, args arguments, inPlural bool) string {
+	var b strings.Builder
+	sequential := 0
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		if strings.HasPrefix(rest, "%%") {
+			b.WriteString("%%")
+			i += 2
+			continue
+		}
+		if match := printfPattern.FindStringSubmatch(rest); match != nil {
+			position := match[1]
+			if position == "" {
+				sequential++
+				position = strconv.Itoa(sequential)
+			}
+			verb := match[5]
+			if v, ok := printfVerbs[verb]; ok {
+				verb = v
+			}
+			b.WriteString("%[" + position + "]" + strings.Replace(match[2], "'", "", -1) + match[3])
+			if match[4] != "" {
+				b.WriteString("." + match[4])
+			}
+			b.WriteString(verb)
+			i += len(match[0])
+			continue
+		}
+		match := templatePattern.FindStringSubmatch(rest)
+		if match == nil {
+			match = simplePattern.FindStringSubmatch(rest)
+		}
+		if n, ok := args.named[matchName(match)]; ok {
+			fmt.Fprintf(&b, "%%[%d]v", n)
+			i += len(match[0])
+			continue
+		}
+
+		switch {
+		case rest[0] == '%':
+			b.WriteString("%%")
+		case rest[0] == '#' && inPlural:
+			fmt.Fprintf(&b, "%%[%d]d", args.count)
+		default:
+			b.WriteByte(rest[0])
+		}
+		i++
+	}
+
+	return b.String()
+}
+
+func matchName(match []string) string {
+	if match == nil {
+		return ""
+	}
+
+	return match[1]
+}
+
+// icuPlural converts string which consists of single ICU plural argument without offset to plural.Selectf
+func icuPlural(s string, args arguments) (catalog.Message, bool) {
+	s = strings.TrimSpace(s)
+	match := pluralPattern.FindStringSubmatch(s)
+	if match == nil || args.named[match[1]] == 0 {
+		return nil, false
+	}
+	count := args
+	count.count = args.named[match[1]]
+
+	forms := map[string]string{}
+	rest := s[len(match[0]):]
+	for {
+		if strings.TrimSpace(rest) == "}" {
+			return selectf(forms, count), len(forms) > 0
+		}
+		selector := selectorPattern.FindStringSubmatch(rest)
+		if selector == nil {
+			return nil, false
+		}
+		rest = rest[len(selector[0]):]
+		end := closingBrace(rest)
+		if end < 0 {
+			return nil, false
+		}
+		forms[selector[1]] = format(rest[:end], count, true)
+		rest = rest[end+1:]
+	}
+}
+
+// closingBrace returns index of brace which closes nested message, -1 is returned when it is not closed
+func closingBrace(s string) int {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,87 @@
+package xtext
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SebastianCzoch/onesky-go"
+	"github.com/SebastianCzoch/onesky-go/formats"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+const (
+	testSource = `{
+  "greeting": "Hello {{name}}, you have %d new messages from {sender}",
+  "progress": "100% done, %1$s of %2$d",
+  "files": {"one": "%d file in {folder}", "other": "%d files in {folder}"},
+  "cats": "{count, plural, =0 {No cats} one {# cat} other {# cats}}",
+  "empty": "Empty"
+}`
+	testTranslation = `{
+  "greeting": "{sender} wysłał {{name}} %d nowych wiadomości",
+  "progress": "100% gotowe, %2$d z %1$@",
+  "files": {"one": "%d plik w {folder}", "few": "%d pliki w {folder}", "many": "%d plików w {folder}", "other": ""},
+  "cats": "{count, plural, =0 {Brak kotów} one {# kot} few {# koty} many {# kotów} other {# kota}}",
+  "empty": ""
+}`
+)
+
+func TestBuild(t *testing.T) {
+	en, err := formats.ParseString(formats.HierarchicalJSON, testSource)
+	assert.Nil(t, err)
+	en.Locale = "en"
+	pl, err := formats.ParseString(formats.HierarchicalJSON, testTranslation)
+	assert.Nil(t, err)
+	pl.Locale = "pl"
+
+	b, err := Build(map[string]*formats.Catalog{"en": en, "pl": pl}, "en")
+	assert.Nil(t, err)
+	assert.Equal(t, []language.Tag{language.English, language.Polish}, b.Languages())
+
+	p := message.NewPrinter(language.Polish, message.Catalog(b))
+	assert.Equal(t, "Ann wysłał John 5 nowych wiadomości", p.Sprintf("greeting", 5, "John", "Ann"))
+	assert.Equal(t, "100% gotowe, 3 z a.txt", p.Sprintf("progress", "a.txt", 3))
+	assert.Equal(t, "1 plik w docs", p.Sprintf("files", 1, "docs"))
+	assert.Equal(t, "3 pliki w docs", p.Sprintf("files", 3, "docs"))
+	assert.Equal(t, "5 plików w docs", p.Sprintf("files", 5, "docs"))
+	assert.Equal(t, "Brak kotów", p.Sprintf("cats", 0))
+	assert.Equal(t, "22 koty", p.Sprintf("cats", 22))
+	assert.Equal(t, "empty", p.Sprintf("empty"))
+
+	p = message.NewPrinter(language.English, message.Catalog(b))
+	assert.Equal(t, "Hello John, you have 1 new messages from Ann", p.Sprintf("greeting", 1, "John", "Ann"))
+	assert.Equal(t, "1 file in docs", p.Sprintf("files", 1, "docs"))
+	assert.Equal(t, "2 files in docs", p.Sprintf("files", 2, "docs"))
+	assert.Equal(t, "1 cat", p.Sprintf("cats", 1))
+
+	_, err = Build(map[string]*formats.Catalog{"": formats.NewCatalog("not a locale!")}, "en")
+	assert.NotNil(t, err)
+}
+
+func TestFormat(t *testing.T) {
+	args := numbering(&formats.Message{Value: "%s {{user}} {n, number} %d%%"})
+	assert.Equal(t, arguments{named: map[string]int{"user": 3, "n": 4}, count: 2}, args)
+	assert.Equal(t, "%[1]s %[3]v %[4]v %[2]d%%", format("%s {{user}} {n, number} %d%%", args, false))
+	assert.Equal(t, "%[2]vx %[1]5.2f 50%% {x} %[2]d", format("%2$@x %1$5.2f 50% {x} #", args, true))
+	assert.Equal(t, arguments{named: map[string]int{"count": 1}, count: 1}, numbering(&formats.Message{Plural: map[string]string{"one": "{{count}} item", "other": "{{count}} items"}}))
+}
+
+func TestDownload(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/languages", httpmock.NewStringResponder(200, `{"meta":{"status":200},"data":[{"code":"en"},{"code":"de"}]}`))
+	httpmock.RegisterResponder("GET", "https://platform.api.onesky.io/1/projects/1/translations", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("locale") == "de" {
+			return httpmock.NewStringResponse(200, `{"title": "Titel"}`), nil
+		}
+		return httpmock.NewStringResponse(200, `{"title": "Title"}`), nil
+	})
+
+	client := &onesky.Client{APIKey: "abcdef", Secret: "abcdef", ProjectID: 1}
+	b, err := Download(client, "en.json", formats.HierarchicalJSON, "en")
+	assert.Nil(t, err)
+	assert.Equal(t, "Titel", message.NewPrinter(language.German, message.Catalog(b)).Sprintf("title"))
+}